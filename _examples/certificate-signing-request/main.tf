@@ -18,8 +18,9 @@ resource "kubernetes_certificate_signing_request" "example" {
     name = "example"
   }
   spec {
-    request = tls_cert_request.example.cert_request_pem
-    usages  = ["client auth", "server auth"]
+    request     = tls_cert_request.example.cert_request_pem
+    signer_name = "kubernetes.io/kube-apiserver-client"
+    usages      = ["client auth"]
   }
   auto_approve = true
 }
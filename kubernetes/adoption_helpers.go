@@ -0,0 +1,197 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+)
+
+// adoptedObjectsKey is the context key of the objects adopted by a create,
+// which are reported as warnings once the create returns.
+type adoptedObjectsKey struct{}
+
+// Resources whose create does not create a Kubernetes object
+var resourcesWithoutCreation = map[string]bool{
+	"kubernetes_default_service_account": true,
+	"kubernetes_node_cordon":             true,
+	"kubernetes_node_labels":             true,
+	"kubernetes_node_taint":              true,
+	"kubernetes_service_account_token":   true,
+}
+
+func adoptExistingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Adopt the object when it already exists instead of failing to create it. Overrides the `adopt_existing` and `adopt_ownership_label` provider arguments.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:        schema.TypeBool,
+					Description: "Whether an existing object is adopted.",
+					Optional:    true,
+					Default:     true,
+				},
+				"ownership_label": {
+					Type:         schema.TypeString,
+					Description:  "Label selector, e.g. `app.kubernetes.io/managed-by=Helm`, which the existing object must match to be adopted.",
+					Optional:     true,
+					ValidateFunc: validateLabelSelectorString,
+				},
+			},
+		},
+	}
+}
+
+// withAdoption adds the adopt_existing argument to a resource and reports
+// the objects adopted by its create as warnings.
+func withAdoption(r *schema.Resource) {
+	r.Schema["adopt_existing"] = adoptExistingSchema()
+
+	f := r.CreateContext
+	if f == nil {
+		return
+	}
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		adopted := []string{}
+		diags := f(context.WithValue(ctx, adoptedObjectsKey{}, &adopted), d, meta)
+		for _, name := range adopted {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Adopted existing object",
+				Detail:   fmt.Sprintf("%s already existed and was adopted. Terraform now manages it and deletes it when the resource is destroyed.", name),
+			})
+		}
+		return diags
+	}
+}
+
+// adoptExisting returns whether an object which already exists should be adopted.
+func adoptExisting(d *schema.ResourceData, meta interface{}) bool {
+	enabled, _ := adoptionSettings(d, meta)
+	return enabled
+}
+
+// adoptionSettings returns whether an object which already exists should be adopted,
+// and the label selector it must match.
+func adoptionSettings(d *schema.ResourceData, meta interface{}) (bool, string) {
+	opts := meta.(KubeClientsets).ApplyOptions()
+	l, ok := d.Get("adopt_existing").([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return opts.adoptExisting, opts.adoptOwnershipLabel
+	}
+	in := l[0].(map[string]interface{})
+
+	label := opts.adoptOwnershipLabel
+	if v, ok := in["ownership_label"].(string); ok && v != "" {
+		label = v
+	}
+	return in["enabled"].(bool), label
+}
+
+// adoptObject adopts the existing object named like obj, see adoptUnstructured,
+// and decodes the object returned by the API server back into obj.
+func adoptObject(ctx context.Context, d *schema.ResourceData, meta interface{}, obj runtime.Object) error {
+	resourceClient, u, err := objectResourceClient(meta, obj)
+	if err != nil {
+		return err
+	}
+
+	out, err := adoptUnstructured(ctx, d, meta, resourceClient, u)
+	if err != nil {
+		return err
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(out.Object, obj)
+}
+
+// applyNewObject creates obj with server-side apply, see applyNewUnstructured,
+// and decodes the object returned by the API server back into obj.
+func applyNewObject(ctx context.Context, d *schema.ResourceData, meta interface{}, obj runtime.Object) error {
+	resourceClient, u, err := objectResourceClient(meta, obj)
+	if err != nil {
+		return err
+	}
+
+	out, err := applyNewUnstructured(ctx, d, meta, resourceClient, u)
+	if err != nil {
+		return err
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(out.Object, obj)
+}
+
+// applyNewUnstructured creates u with server-side apply. An apply also succeeds when the object
+// already exists, so the existing object is only taken over when it may be adopted, like the
+// create of the other modes.
+func applyNewUnstructured(ctx context.Context, d *schema.ResourceData, meta interface{}, client dynamic.ResourceInterface, u *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	_, err := client.Get(ctx, u.GetName(), metav1.GetOptions{})
+	if err == nil {
+		if !adoptExisting(d, meta) {
+			return nil, fmt.Errorf("%s %q already exists, set adopt_existing to adopt it", u.GetKind(), u.GetName())
+		}
+		return adoptUnstructured(ctx, d, meta, client, u)
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	return applyUnstructured(ctx, meta, client, u)
+}
+
+// adoptUnstructured checks that the existing object named like u matches the ownership label,
+// and brings it to the desired state of u with a server-side apply forcing conflicts.
+func adoptUnstructured(ctx context.Context, d *schema.ResourceData, meta interface{}, client dynamic.ResourceInterface, u *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	_, label := adoptionSettings(d, meta)
+
+	live, err := client.Get(ctx, u.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	err = checkAdoptionOwnership(live, label)
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("%s %q", u.GetKind(), u.GetName())
+	if ns := u.GetNamespace(); ns != "" {
+		name = fmt.Sprintf("%s %q", u.GetKind(), ns+"/"+u.GetName())
+	}
+	log.Printf("[WARN] %s already exists, adopting it", name)
+
+	opts := meta.(KubeClientsets).ApplyOptions().patchOptions()
+	opts.Force = ptrToBool(true)
+	out, err := applyUnstructuredWithOptions(ctx, client, u, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to adopt existing %s: %s", name, err)
+	}
+
+	if adopted, ok := ctx.Value(adoptedObjectsKey{}).(*[]string); ok {
+		*adopted = append(*adopted, name)
+	}
+	return out, nil
+}
+
+// checkAdoptionOwnership returns an error when the existing object does not match the label selector.
+func checkAdoptionOwnership(live *unstructured.Unstructured, label string) error {
+	if label == "" {
+		return nil
+	}
+	selector, err := labels.Parse(label)
+	if err != nil {
+		return err
+	}
+	if !selector.Matches(labels.Set(live.GetLabels())) {
+		return fmt.Errorf("%s %q already exists and does not match the ownership label %q, refusing to adopt it", live.GetKind(), live.GetName(), label)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// testResourceClient serves a single object, and records the patches applied to it
+type testResourceClient struct {
+	dynamic.ResourceInterface
+	object  *unstructured.Unstructured
+	patches int
+}
+
+func (c *testResourceClient) Get(ctx context.Context, name string, opts metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if c.object == nil {
+		return nil, errors.NewNotFound(apimachineryschema.GroupResource{Resource: "configmaps"}, name)
+	}
+	return c.object, nil
+}
+
+func (c *testResourceClient) Patch(ctx context.Context, name string, pt pkgApi.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	c.patches++
+	return c.object, nil
+}
+
+func TestCheckAdoptionOwnership(t *testing.T) {
+	testCases := []struct {
+		Labels   map[string]string
+		Label    string
+		Expected bool
+	}{
+		{nil, "", true},
+		{nil, "app.kubernetes.io/managed-by=Helm", false},
+		{map[string]string{"app.kubernetes.io/managed-by": "Helm"}, "app.kubernetes.io/managed-by=Helm", true},
+		{map[string]string{"app.kubernetes.io/managed-by": "kubectl"}, "app.kubernetes.io/managed-by=Helm", false},
+		{map[string]string{"app.kubernetes.io/managed-by": "kubectl"}, "app.kubernetes.io/managed-by", true},
+		{map[string]string{"team": "platform"}, "team in (platform, infra)", true},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			live := &unstructured.Unstructured{}
+			live.SetKind("ConfigMap")
+			live.SetName("test")
+			live.SetLabels(tc.Labels)
+
+			err := checkAdoptionOwnership(live, tc.Label)
+			if (err == nil) != tc.Expected {
+				t.Fatalf("Unexpected result of %q for labels %v: %v", tc.Label, tc.Labels, err)
+			}
+		})
+	}
+}
+
+func TestAdoptionSettings(t *testing.T) {
+	testCases := []struct {
+		Provider applyOptions
+		Config   map[string]interface{}
+		Enabled  bool
+		Label    string
+	}{
+		{
+			applyOptions{},
+			map[string]interface{}{},
+			false, "",
+		},
+		{
+			applyOptions{adoptExisting: true, adoptOwnershipLabel: "owner=platform"},
+			map[string]interface{}{},
+			true, "owner=platform",
+		},
+		{
+			applyOptions{},
+			map[string]interface{}{"adopt_existing": []interface{}{map[string]interface{}{}}},
+			true, "",
+		},
+		{
+			applyOptions{adoptExisting: true, adoptOwnershipLabel: "owner=platform"},
+			map[string]interface{}{"adopt_existing": []interface{}{map[string]interface{}{"enabled": false}}},
+			false, "owner=platform",
+		},
+		{
+			applyOptions{adoptOwnershipLabel: "owner=platform"},
+			map[string]interface{}{"adopt_existing": []interface{}{map[string]interface{}{"ownership_label": "owner=web"}}},
+			true, "owner=web",
+		},
+	}
+
+	s := map[string]*schema.Schema{"adopt_existing": adoptExistingSchema()}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, s, tc.Config)
+			meta := &kubeClientsets{applyOptions: tc.Provider}
+
+			enabled, label := adoptionSettings(d, meta)
+			if enabled != tc.Enabled || label != tc.Label {
+				t.Fatalf("Unexpected settings: %t %q, expected %t %q", enabled, label, tc.Enabled, tc.Label)
+			}
+		})
+	}
+}
+
+func TestWithAdoption(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if adopted, ok := ctx.Value(adoptedObjectsKey{}).(*[]string); ok {
+				*adopted = append(*adopted, `ConfigMap "default/test"`)
+			}
+			d.SetId("default/test")
+			return nil
+		},
+	}
+	withAdoption(r)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	diags := r.CreateContext(context.Background(), d, nil)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected a warning about the adopted object, given: %#v", diags)
+	}
+}
+
+func TestApplyNewUnstructured(t *testing.T) {
+	existing := &unstructured.Unstructured{}
+	existing.SetAPIVersion("v1")
+	existing.SetKind("ConfigMap")
+	existing.SetName("test")
+	existing.SetLabels(map[string]string{"owner": "kubectl"})
+
+	testCases := []struct {
+		Existing      *unstructured.Unstructured
+		Provider      applyOptions
+		ExpectError   bool
+		ExpectAdopted bool
+	}{
+		{nil, applyOptions{}, false, false},
+		{existing, applyOptions{}, true, false},
+		{existing, applyOptions{adoptExisting: true, adoptOwnershipLabel: "owner=platform"}, true, false},
+		{existing, applyOptions{adoptExisting: true}, false, true},
+	}
+
+	s := map[string]*schema.Schema{"adopt_existing": adoptExistingSchema()}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+			meta := &kubeClientsets{applyOptions: tc.Provider}
+			client := &testResourceClient{object: tc.Existing}
+			u := existing.DeepCopy()
+			u.SetLabels(nil)
+
+			adopted := []string{}
+			ctx := context.WithValue(context.Background(), adoptedObjectsKey{}, &adopted)
+			_, err := applyNewUnstructured(ctx, d, meta, client, u)
+			if (err != nil) != tc.ExpectError {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.ExpectError && client.patches > 0 {
+				t.Fatalf("Expected the existing object not to be applied")
+			}
+			if (len(adopted) > 0) != tc.ExpectAdopted {
+				t.Fatalf("Unexpected adopted objects: %q", adopted)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+)
+
+// apiVersions remembers the group version negotiated for each resource,
+// per cluster, so discovery only runs once per plugin process.
+var apiVersions = &apiVersionCache{entries: make(map[discovery.DiscoveryInterface]map[string]string)}
+
+type apiVersionCache struct {
+	lock    sync.Mutex
+	entries map[discovery.DiscoveryInterface]map[string]string
+}
+
+func (c *apiVersionCache) get(d discovery.DiscoveryInterface, key string) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	gv, ok := c.entries[d][key]
+	return gv, ok
+}
+
+func (c *apiVersionCache) set(d discovery.DiscoveryInterface, key, gv string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.entries[d] == nil {
+		c.entries[d] = make(map[string]string)
+	}
+	c.entries[d][key] = gv
+}
+
+// negotiateAPIVersion returns the first of the candidate group versions,
+// listed from newest to oldest, in which the server serves the resource.
+func negotiateAPIVersion(d discovery.DiscoveryInterface, resource string, candidates ...string) (string, error) {
+	key := resource + " " + strings.Join(candidates, ",")
+	if gv, ok := apiVersions.get(d, key); ok {
+		return gv, nil
+	}
+
+	for _, gv := range candidates {
+		list, err := d.ServerResourcesForGroupVersion(gv)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return "", fmt.Errorf("Failed to discover the resources of %s: %s", gv, err)
+		}
+		for _, r := range list.APIResources {
+			if r.Name == resource {
+				log.Printf("[INFO] Using %s for %s", gv, resource)
+				apiVersions.set(d, key, gv)
+				return gv, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("The server does not serve %s in any of the supported API versions: %s", resource, strings.Join(candidates, ", "))
+}
+
+// versionedResource reads and writes typed objects in the group version
+// negotiated with the server, through the dynamic client. The typed objects
+// must have the same JSON representation as the negotiated version.
+type versionedResource struct {
+	gvk    apimachineryschema.GroupVersionKind
+	client dynamic.ResourceInterface
+}
+
+func newVersionedResource(meta interface{}, resource, kind, namespace string, candidates ...string) (*versionedResource, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	v, err := negotiateAPIVersion(conn.Discovery(), resource, candidates...)
+	if err != nil {
+		return nil, err
+	}
+	gv, err := apimachineryschema.ParseGroupVersion(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	var client dynamic.ResourceInterface = dc.Resource(gv.WithResource(resource))
+	if namespace != "" {
+		client = dc.Resource(gv.WithResource(resource)).Namespace(namespace)
+	}
+
+	return &versionedResource{
+		gvk:    gv.WithKind(kind),
+		client: client,
+	}, nil
+}
+
+// GroupVersion returns the negotiated group version, such as "batch/v1"
+func (r *versionedResource) GroupVersion() string {
+	return r.gvk.GroupVersion().String()
+}
+
+func (r *versionedResource) toUnstructured(in interface{}) (*unstructured.Unstructured, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(in)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{Object: m}
+	u.SetGroupVersionKind(r.gvk)
+	return u, nil
+}
+
+func (r *versionedResource) decode(u *unstructured.Unstructured, out interface{}) error {
+	if out == nil {
+		return nil
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, out)
+}
+
+func (r *versionedResource) Get(ctx context.Context, name string, out interface{}) error {
+	u, err := r.client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	return r.decode(u, out)
+}
+
+func (r *versionedResource) Create(ctx context.Context, in interface{}, out interface{}) error {
+	u, err := r.toUnstructured(in)
+	if err != nil {
+		return err
+	}
+	u, err = r.client.Create(ctx, u, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	return r.decode(u, out)
+}
+
+func (r *versionedResource) Update(ctx context.Context, in interface{}, out interface{}, subresources ...string) error {
+	u, err := r.toUnstructured(in)
+	if err != nil {
+		return err
+	}
+	u, err = r.client.Update(ctx, u, metav1.UpdateOptions{}, subresources...)
+	if err != nil {
+		return err
+	}
+	return r.decode(u, out)
+}
+
+func (r *versionedResource) Patch(ctx context.Context, name string, pt pkgApi.PatchType, data []byte, out interface{}) error {
+	u, err := r.client.Patch(ctx, name, pt, data, metav1.PatchOptions{})
+	if err != nil {
+		return err
+	}
+	return r.decode(u, out)
+}
+
+// Apply creates or updates the object with server-side apply
+func (r *versionedResource) Apply(ctx context.Context, meta interface{}, in interface{}, out interface{}) error {
+	u, err := r.toUnstructured(in)
+	if err != nil {
+		return err
+	}
+	delete(u.Object, "status")
+	pruneUnsetFields(reflect.ValueOf(in), u.Object)
+	u, err = applyUnstructured(ctx, meta, r.client, u)
+	if err != nil {
+		return err
+	}
+	return r.decode(u, out)
+}
+
+// ApplyNew creates the object with server-side apply, see applyNewUnstructured
+func (r *versionedResource) ApplyNew(ctx context.Context, d *schema.ResourceData, meta interface{}, in interface{}, out interface{}) error {
+	u, err := r.toUnstructured(in)
+	if err != nil {
+		return err
+	}
+	delete(u.Object, "status")
+	pruneUnsetFields(reflect.ValueOf(in), u.Object)
+	u, err = applyNewUnstructured(ctx, d, meta, r.client, u)
+	if err != nil {
+		return err
+	}
+	return r.decode(u, out)
+}
+
+// Adopt brings the existing object named like in to its desired state, see adoptUnstructured
+func (r *versionedResource) Adopt(ctx context.Context, d *schema.ResourceData, meta interface{}, in interface{}, out interface{}) error {
+	u, err := r.toUnstructured(in)
+	if err != nil {
+		return err
+	}
+	delete(u.Object, "status")
+	pruneUnsetFields(reflect.ValueOf(in), u.Object)
+	u, err = adoptUnstructured(ctx, d, meta, r.client, u)
+	if err != nil {
+		return err
+	}
+	return r.decode(u, out)
+}
+
+func (r *versionedResource) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return r.client.Delete(ctx, name, opts)
+}
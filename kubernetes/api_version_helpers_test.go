@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// testDiscovery serves the resources of a fixed set of group versions
+type testDiscovery struct {
+	discovery.DiscoveryInterface
+	resources map[string][]string
+	calls     int
+}
+
+func (d *testDiscovery) ServerResourcesForGroupVersion(gv string) (*metav1.APIResourceList, error) {
+	d.calls++
+	names, ok := d.resources[gv]
+	if !ok {
+		gr := apimachineryschema.GroupResource{Group: gv}
+		return nil, errors.NewNotFound(gr, "")
+	}
+	list := &metav1.APIResourceList{GroupVersion: gv}
+	for _, n := range names {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: n})
+	}
+	return list, nil
+}
+
+func TestNegotiateAPIVersion(t *testing.T) {
+	d := &testDiscovery{resources: map[string][]string{
+		"batch/v1":      {"jobs"},
+		"batch/v1beta1": {"cronjobs"},
+		"policy/v1":     {"poddisruptionbudgets"},
+	}}
+
+	gv, err := negotiateAPIVersion(d, "cronjobs", "batch/v1", "batch/v1beta1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gv != "batch/v1beta1" {
+		t.Fatalf("Expected batch/v1beta1, given: %s", gv)
+	}
+
+	gv, err = negotiateAPIVersion(d, "poddisruptionbudgets", "policy/v1", "policy/v1beta1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gv != "policy/v1" {
+		t.Fatalf("Expected policy/v1, given: %s", gv)
+	}
+
+	calls := d.calls
+	_, err = negotiateAPIVersion(d, "cronjobs", "batch/v1", "batch/v1beta1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.calls != calls {
+		t.Fatalf("Expected the negotiated version to be cached, discovery was called %d more times", d.calls-calls)
+	}
+
+	_, err = negotiateAPIVersion(d, "ingresses", "networking.k8s.io/v1", "extensions/v1beta1")
+	if err == nil {
+		t.Fatal("Expected an error for a resource which is not served")
+	}
+}
@@ -0,0 +1,263 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/restmapper"
+	aggregatorscheme "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset/scheme"
+)
+
+type applyOptions struct {
+	serverSide     bool
+	fieldManager   string
+	forceConflicts bool
+
+	// adoptExisting and adoptOwnershipLabel are the provider defaults of adopt_existing
+	adoptExisting       bool
+	adoptOwnershipLabel string
+}
+
+func (o applyOptions) patchOptions() metav1.PatchOptions {
+	return metav1.PatchOptions{
+		FieldManager: o.fieldManager,
+		Force:        ptrToBool(o.forceConflicts),
+	}
+}
+
+// applyScheme knows the kinds of all typed objects managed by the provider
+var applyScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(kubernetesscheme.AddToScheme(applyScheme))
+	utilruntime.Must(aggregatorscheme.AddToScheme(applyScheme))
+}
+
+func useServerSideApply(meta interface{}) bool {
+	return meta.(KubeClientsets).ApplyOptions().serverSide
+}
+
+// applyObject creates or updates obj with server-side apply
+// and decodes the object returned by the API server back into obj.
+func applyObject(ctx context.Context, meta interface{}, obj runtime.Object) error {
+	resourceClient, u, err := objectResourceClient(meta, obj)
+	if err != nil {
+		return err
+	}
+
+	out, err := applyUnstructured(ctx, meta, resourceClient, u)
+	if err != nil {
+		return err
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(out.Object, obj)
+}
+
+// applyResourceUpdate updates the object built from the configuration of a resource with server-side apply
+// and reads the resource back. Updates use it instead of the create of the resource, whose waits and other
+// side effects only apply to new objects.
+func applyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, obj runtime.Object, read schema.ReadContextFunc) diag.Diagnostics {
+	err := applyObject(ctx, meta, obj)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted updated object: %#v", obj)
+	return read(ctx, d, meta)
+}
+
+// objectResourceClient converts a typed object to unstructured, without its status or unset fields,
+// and returns the dynamic client of its kind.
+func objectResourceClient(meta interface{}, obj runtime.Object) (dynamic.ResourceInterface, *unstructured.Unstructured, error) {
+	gvks, _, err := applyScheme.ObjectKinds(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	gvk := gvks[0]
+
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := &unstructured.Unstructured{Object: m}
+	u.SetGroupVersionKind(gvk)
+	delete(u.Object, "status")
+
+	pruneUnsetFields(reflect.ValueOf(obj), u.Object)
+
+	mapping, err := restMappingForGroupVersionKind(meta, gvk)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	if mapping.Scope.Name() != apimeta.RESTScopeNameNamespace {
+		return client.Resource(mapping.Resource), u, nil
+	}
+	return client.Resource(mapping.Resource).Namespace(u.GetNamespace()), u, nil
+}
+
+// restMappings remembers the REST mapping of each kind, per cluster,
+// so the API resources are only discovered once per plugin process.
+var restMappings = &restMappingCache{entries: make(map[discovery.DiscoveryInterface]map[apimachineryschema.GroupVersionKind]*apimeta.RESTMapping)}
+
+type restMappingCache struct {
+	lock    sync.Mutex
+	entries map[discovery.DiscoveryInterface]map[apimachineryschema.GroupVersionKind]*apimeta.RESTMapping
+}
+
+// restMappingForGroupVersionKind resolves the resource serving a kind through a discovery RESTMapper.
+func restMappingForGroupVersionKind(meta interface{}, gvk apimachineryschema.GroupVersionKind) (*apimeta.RESTMapping, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	d := conn.Discovery()
+
+	restMappings.lock.Lock()
+	defer restMappings.lock.Unlock()
+	if mapping, ok := restMappings.entries[d][gvk]; ok {
+		return mapping, nil
+	}
+
+	groupResources, err := restmapper.GetAPIGroupResources(d)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to discover API resources: %s", err)
+	}
+	mapping, err := restmapper.NewDiscoveryRESTMapper(groupResources).RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to find the API resource for %s: %s", gvk, err)
+	}
+
+	if restMappings.entries[d] == nil {
+		restMappings.entries[d] = make(map[apimachineryschema.GroupVersionKind]*apimeta.RESTMapping)
+	}
+	restMappings.entries[d][gvk] = mapping
+	return mapping, nil
+}
+
+// pruneUnsetFields removes from m, the unstructured form of the typed value v, the null values
+// and the struct fields left at their zero value, which JSON omitempty does not drop for structs.
+// Server-side apply would otherwise take ownership of the fields the configuration leaves unset.
+func pruneUnsetFields(v reflect.Value, m map[string]interface{}) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		tag := strings.Split(f.Tag.Get("json"), ",")
+		name := tag[0]
+		if name == "-" {
+			continue
+		}
+		fv := v.Field(i)
+		if name == "" && f.Anonymous {
+			pruneUnsetFields(fv, m)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		value, ok := m[name]
+		if !ok {
+			continue
+		}
+		omitEmpty := len(tag) > 1 && strings.Contains(strings.Join(tag[1:], ","), "omitempty")
+		if value == nil || omitEmpty && fv.Kind() == reflect.Struct && fv.IsZero() {
+			delete(m, name)
+			continue
+		}
+
+		switch value := value.(type) {
+		case map[string]interface{}:
+			pruneUnsetFields(fv, value)
+		case []interface{}:
+			if fv.Kind() != reflect.Slice || fv.Len() != len(value) {
+				continue
+			}
+			for j, e := range value {
+				if em, ok := e.(map[string]interface{}); ok {
+					pruneUnsetFields(fv.Index(j), em)
+				}
+			}
+		}
+	}
+}
+
+// applyUnstructured creates or updates u with server-side apply through client.
+func applyUnstructured(ctx context.Context, meta interface{}, client dynamic.ResourceInterface, u *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return applyUnstructuredWithOptions(ctx, client, u, meta.(KubeClientsets).ApplyOptions().patchOptions())
+}
+
+func applyUnstructuredWithOptions(ctx context.Context, client dynamic.ResourceInterface, u *unstructured.Unstructured, opts metav1.PatchOptions) (*unstructured.Unstructured, error) {
+	if u.GetName() == "" {
+		return nil, fmt.Errorf("Server-side apply requires %q, %q is not supported", "metadata.name", "metadata.generate_name")
+	}
+
+	data, err := json.Marshal(u.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Applying %s %q: %s", u.GetKind(), u.GetName(), string(data))
+	out, err := client.Patch(ctx, u.GetName(), pkgApi.ApplyPatchType, data, opts)
+	if err != nil {
+		return nil, applyConflictError(err)
+	}
+	return out, nil
+}
+
+// applyConflictError turns a server-side apply conflict into an error
+// naming the competing field managers and the fields they own.
+func applyConflictError(err error) error {
+	statusErr, ok := err.(*errors.StatusError)
+	if !ok || !errors.IsConflict(err) || statusErr.ErrStatus.Details == nil {
+		return err
+	}
+
+	conflicts := []string{}
+	for _, c := range statusErr.ErrStatus.Details.Causes {
+		if c.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		conflicts = append(conflicts, fmt.Sprintf("\n   * %s: %s", c.Field, c.Message))
+	}
+	if len(conflicts) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("Server-side apply failed with %d conflict(s):%s\n\n"+
+		"Remove the fields from the configuration, or set force_conflicts = true in the provider configuration to take ownership of them.",
+		len(conflicts), strings.Join(conflicts, ""))
+}
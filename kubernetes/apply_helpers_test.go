@@ -0,0 +1,104 @@
+package kubernetes
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	api "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestApplyConflictError(t *testing.T) {
+	conflict := apierrors.NewApplyConflict([]metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "kubectl-client-side-apply" using apps/v1`,
+			Field:   ".spec.replicas",
+		},
+		{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "hpa-controller" using autoscaling/v1`,
+			Field:   ".spec.template.spec.containers[name=\"nginx\"].image",
+		},
+	}, "Apply failed with 2 conflicts")
+
+	err := applyConflictError(conflict)
+	for _, s := range []string{
+		"2 conflict(s)",
+		`.spec.replicas: conflict with "kubectl-client-side-apply" using apps/v1`,
+		`conflict with "hpa-controller"`,
+		"force_conflicts",
+	} {
+		if !strings.Contains(err.Error(), s) {
+			t.Fatalf("Expected error to contain %q, given: %s", s, err)
+		}
+	}
+
+	for _, e := range []error{
+		errors.New("connection refused"),
+		apierrors.NewNotFound(schema.GroupResource{Resource: "deployments"}, "test"),
+	} {
+		if applyConflictError(e) != e {
+			t.Fatalf("Expected %q to be returned unchanged", e)
+		}
+	}
+}
+
+func TestPruneUnsetFields(t *testing.T) {
+	pod := &api.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: api.PodSpec{
+			Containers: []api.Container{{Name: "app", Image: "nginx"}},
+			Volumes: []api.Volume{{
+				Name:         "cache",
+				VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}},
+			}},
+		},
+	}
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pruneUnsetFields(reflect.ValueOf(pod), m)
+
+	u := &unstructured.Unstructured{Object: m}
+	if _, ok, _ := unstructured.NestedFieldNoCopy(m, "metadata", "creationTimestamp"); ok {
+		t.Fatalf("Expected the unset creation timestamp to be pruned")
+	}
+	containers, _, _ := unstructured.NestedSlice(m, "spec", "containers")
+	if _, ok := containers[0].(map[string]interface{})["resources"]; ok {
+		t.Fatalf("Expected the unset container resources to be pruned")
+	}
+	volumes, _, _ := unstructured.NestedSlice(m, "spec", "volumes")
+	if _, ok := volumes[0].(map[string]interface{})["emptyDir"]; !ok {
+		t.Fatalf("Expected the configured empty dir volume to be kept")
+	}
+	if u.GetName() != "test" || u.GetNamespace() != "default" {
+		t.Fatalf("Unexpected metadata: %#v", u.Object["metadata"])
+	}
+}
+
+func TestPruneUnsetFields_requiredStruct(t *testing.T) {
+	policy := &networking.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: "default"},
+		Spec: networking.NetworkPolicySpec{
+			PolicyTypes: []networking.PolicyType{networking.PolicyTypeIngress},
+		},
+	}
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pruneUnsetFields(reflect.ValueOf(policy), m)
+
+	if _, ok, _ := unstructured.NestedFieldNoCopy(m, "spec", "podSelector"); !ok {
+		t.Fatalf("Expected the required pod selector to be kept")
+	}
+}
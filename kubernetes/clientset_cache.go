@@ -0,0 +1,173 @@
+package kubernetes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	aggregator "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
+)
+
+// clientsets is shared by all provider instances in the plugin process,
+// so each distinct client configuration gets a single HTTP transport.
+var clientsets = &clientsetCache{entries: make(map[string]*cachedClientsets)}
+
+type clientsetCache struct {
+	lock    sync.Mutex
+	entries map[string]*cachedClientsets
+}
+
+type cachedClientsets struct {
+	main       *kubernetes.Clientset
+	aggregator *aggregator.Clientset
+	dynamic    dynamic.Interface
+}
+
+func (c *clientsetCache) mainClientset(cfg *restclient.Config) (*kubernetes.Clientset, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e, err := c.entry(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if e.main == nil {
+		kc, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		e.main = kc
+	}
+	return e.main, nil
+}
+
+func (c *clientsetCache) aggregatorClientset(cfg *restclient.Config) (*aggregator.Clientset, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e, err := c.entry(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if e.aggregator == nil {
+		ac, err := aggregator.NewForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		e.aggregator = ac
+	}
+	return e.aggregator, nil
+}
+
+func (c *clientsetCache) dynamicClient(cfg *restclient.Config) (dynamic.Interface, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e, err := c.entry(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if e.dynamic == nil {
+		dc, err := dynamic.NewForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		e.dynamic = dc
+	}
+	return e.dynamic, nil
+}
+
+// entry must be called with the lock held
+func (c *clientsetCache) entry(cfg *restclient.Config) (*cachedClientsets, error) {
+	key, err := clientsetCacheKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+	e, ok := c.entries[key]
+	if !ok {
+		e = &cachedClientsets{}
+		c.entries[key] = e
+	}
+	return e, nil
+}
+
+// clientsetCacheKey identifies a client configuration by the settings
+// which change how requests are sent and authenticated. Functions can't be
+// compared, the proxy is identified by the URL it returns for the host, and
+// the transport wrapper by its code, which is the same for every instance of
+// the logging wrapper set by configureTransport.
+func clientsetCacheKey(cfg *restclient.Config) (string, error) {
+	var proxy string
+	if cfg.Proxy != nil {
+		host := cfg.Host
+		if !strings.Contains(host, "://") {
+			host = "https://" + host
+		}
+		u, err := url.Parse(host)
+		if err != nil {
+			return "", err
+		}
+		p, err := cfg.Proxy(&http.Request{URL: u})
+		if err != nil {
+			return "", err
+		}
+		if p != nil {
+			proxy = p.String()
+		}
+	}
+	var wrapTransport uintptr
+	if cfg.WrapTransport != nil {
+		wrapTransport = reflect.ValueOf(cfg.WrapTransport).Pointer()
+	}
+
+	b, err := json.Marshal(struct {
+		Host            string
+		APIPath         string
+		Username        string
+		Password        string
+		BearerToken     string
+		BearerTokenFile string
+		Impersonate     restclient.ImpersonationConfig
+		AuthProvider    *clientcmdapi.AuthProviderConfig
+		ExecProvider    *clientcmdapi.ExecConfig
+		TLSClientConfig restclient.TLSClientConfig
+		UserAgent       string
+		QPS             float32
+		Burst           int
+		Timeout         time.Duration
+		Proxy           string
+		WrapTransport   uintptr
+	}{
+		Host:            cfg.Host,
+		APIPath:         cfg.APIPath,
+		Username:        cfg.Username,
+		Password:        cfg.Password,
+		BearerToken:     cfg.BearerToken,
+		BearerTokenFile: cfg.BearerTokenFile,
+		Impersonate:     cfg.Impersonate,
+		AuthProvider:    cfg.AuthProvider,
+		ExecProvider:    cfg.ExecProvider,
+		TLSClientConfig: cfg.TLSClientConfig,
+		UserAgent:       cfg.UserAgent,
+		QPS:             cfg.QPS,
+		Burst:           cfg.Burst,
+		Timeout:         cfg.Timeout,
+		Proxy:           proxy,
+		WrapTransport:   wrapTransport,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
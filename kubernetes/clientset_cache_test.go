@@ -0,0 +1,181 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestClientsetCache_sharedByConfig(t *testing.T) {
+	newConfig := func(host string) *restclient.Config {
+		return &restclient.Config{
+			Host:        host,
+			BearerToken: "token",
+			UserAgent:   "HashiCorp/1.0 Terraform/0.14.0",
+		}
+	}
+
+	k := &kubeClientsets{config: newConfig("https://cache-test-one.example.com")}
+	results := make([]*kubernetes.Clientset, 10)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Equal configurations built separately share a clientset
+			c := k
+			if i%2 == 1 {
+				c = &kubeClientsets{config: newConfig("https://cache-test-one.example.com")}
+			}
+			kc, err := c.MainClientset()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			results[i] = kc
+		}(i)
+	}
+	wg.Wait()
+
+	for i, kc := range results {
+		if kc == nil || kc != results[0] {
+			t.Fatalf("Expected clientset %d to be shared, given: %p and %p", i, kc, results[0])
+		}
+	}
+
+	other, err := (&kubeClientsets{config: newConfig("https://cache-test-two.example.com")}).MainClientset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == results[0] {
+		t.Fatal("Expected a different host to use a different clientset")
+	}
+}
+
+func TestClientsetCacheKey(t *testing.T) {
+	execConfig := func(token string) *restclient.Config {
+		return &restclient.Config{
+			Host: "https://cache-key.example.com",
+			ExecProvider: &clientcmdapi.ExecConfig{
+				APIVersion: "client.authentication.k8s.io/v1beta1",
+				Command:    "get-token",
+				Env:        []clientcmdapi.ExecEnvVar{{Name: "TOKEN", Value: token}},
+			},
+		}
+	}
+	throttled := func(qps float32) *restclient.Config {
+		return &restclient.Config{Host: "https://cache-key.example.com", QPS: qps}
+	}
+	proxied := func(proxy string) *restclient.Config {
+		u, _ := url.Parse(proxy)
+		return &restclient.Config{Host: "https://cache-key.example.com", Proxy: http.ProxyURL(u)}
+	}
+	traced := func(enabled bool) *restclient.Config {
+		cfg := &restclient.Config{Host: "https://cache-key.example.com"}
+		if enabled {
+			cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+				return rt
+			}
+		}
+		return cfg
+	}
+
+	testCases := []struct {
+		A, B  *restclient.Config
+		Equal bool
+	}{
+		{execConfig("one"), execConfig("one"), true},
+		{execConfig("one"), execConfig("two"), false},
+		{throttled(5), throttled(5), true},
+		{throttled(5), throttled(50), false},
+		{proxied("http://proxy-one:3128"), proxied("http://proxy-one:3128"), true},
+		{proxied("http://proxy-one:3128"), proxied("http://proxy-two:3128"), false},
+		{proxied("http://proxy-one:3128"), throttled(0), false},
+		{traced(true), traced(true), true},
+		{traced(true), traced(false), false},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a, err := clientsetCacheKey(tc.A)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := clientsetCacheKey(tc.B)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (a == b) != tc.Equal {
+				t.Fatalf("Expected keys to be equal: %t, given: %q and %q", tc.Equal, a, b)
+			}
+		})
+	}
+}
+
+// newFakeAPIServer serves a single config map and counts the connections
+// opened by clients, each of which costs a TLS handshake.
+func newFakeAPIServer(b *testing.B) (*restclient.Config, *int64) {
+	var conns int64
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test","namespace":"default"},"data":{"one":"1"}}`)
+	}))
+	ts.Config.ConnState = func(c net.Conn, s http.ConnState) {
+		if s == http.StateNew {
+			atomic.AddInt64(&conns, 1)
+		}
+	}
+	ts.StartTLS()
+	b.Cleanup(ts.Close)
+
+	cfg := &restclient.Config{
+		Host:            ts.URL,
+		TLSClientConfig: restclient.TLSClientConfig{Insecure: true},
+		QPS:             1e6,
+		Burst:           1e6,
+	}
+	return cfg, &conns
+}
+
+func benchmarkGetConfigMap(b *testing.B, clientset func(cfg *restclient.Config) (*kubernetes.Clientset, error)) {
+	cfg, conns := newFakeAPIServer(b)
+	ctx := context.TODO()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		conn, err := clientset(cfg)
+		if err != nil {
+			b.Fatal(err)
+		}
+		_, err = conn.CoreV1().ConfigMaps("default").Get(ctx, "test", metav1.GetOptions{})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(atomic.LoadInt64(conns))/float64(b.N), "conns/op")
+}
+
+func BenchmarkMainClientset_cached(b *testing.B) {
+	var k *kubeClientsets
+	benchmarkGetConfigMap(b, func(cfg *restclient.Config) (*kubernetes.Clientset, error) {
+		if k == nil {
+			k = &kubeClientsets{config: cfg}
+		}
+		return k.MainClientset()
+	})
+}
+
+func BenchmarkMainClientset_uncached(b *testing.B) {
+	benchmarkGetConfigMap(b, kubernetes.NewForConfig)
+}
@@ -0,0 +1,221 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Provider attributes which can also be set in a named cluster block
+var clusterConnectionAttributes = []string{
+	"host",
+	"username",
+	"password",
+	"insecure",
+	"client_certificate",
+	"client_key",
+	"cluster_ca_certificate",
+	"config_paths",
+	"config_path",
+	"config_context",
+	"config_context_auth_info",
+	"config_context_cluster",
+	"token",
+	"exec",
+	"qps",
+	"burst",
+}
+
+// Separates the cluster name from the resource ID on import, e.g. "staging//default/nginx"
+const clusterImportIdSeparator = "//"
+
+// clusterResource builds the schema of a named cluster block from the
+// connection attributes of the provider. Unlike the provider attributes,
+// the ones of a cluster block are not sourced from the environment.
+func clusterResource(provider map[string]*schema.Schema) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Name of the cluster, referenced by the `cluster` argument of resources and data sources.",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+	}
+	for _, k := range clusterConnectionAttributes {
+		a := *provider[k]
+		a.DefaultFunc = nil
+		a.ConflictsWith = nil
+		s[k] = &a
+	}
+	return &schema.Resource{Schema: s}
+}
+
+// clusterRegistry lazily builds the clientsets of the named cluster blocks
+// and shares them between all resources and data sources.
+type clusterRegistry struct {
+	sync.Mutex
+
+	configData       *schema.ResourceData
+	terraformVersion string
+	prefixes         map[string]string
+	clientsets       map[string]KubeClientsets
+
+	// defaults holds the settings shared by all clusters, e.g. apply options
+	defaults *kubeClientsets
+}
+
+func expandClusters(d *schema.ResourceData, terraformVersion string) (*clusterRegistry, error) {
+	r := &clusterRegistry{
+		configData:       d,
+		terraformVersion: terraformVersion,
+		prefixes:         make(map[string]string),
+		clientsets:       make(map[string]KubeClientsets),
+	}
+	for i, v := range d.Get("cluster").([]interface{}) {
+		c, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := c["name"].(string)
+		if _, ok := r.prefixes[name]; ok {
+			return nil, fmt.Errorf("Duplicate cluster name %q in the provider configuration", name)
+		}
+		r.prefixes[name] = fmt.Sprintf("cluster.%d.", i)
+	}
+	return r, nil
+}
+
+func (r *clusterRegistry) get(name string) (KubeClientsets, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if c, ok := r.clientsets[name]; ok {
+		return c, nil
+	}
+	prefix, ok := r.prefixes[name]
+	if !ok {
+		return nil, fmt.Errorf("Cluster %q is not defined in the provider configuration", name)
+	}
+
+	cfg, err := initializeConfiguration(r.configData, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to configure cluster %q: %s", name, err)
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("Failed to configure cluster %q: the configuration is incomplete or invalid", name)
+	}
+	configureTransport(cfg, r.terraformVersion)
+
+	c := r.defaults.withConfig(cfg)
+	r.clientsets[name] = c
+	return c, nil
+}
+
+// withClusterSelection adds the cluster argument to a resource or data source
+// and hands the clientsets of the selected cluster to its functions.
+func withClusterSelection(r *schema.Resource, dataSource bool) {
+	r.Schema["cluster"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    !dataSource,
+		Description: "Name of the provider `cluster` block to use. Defaults to the cluster configured at the top level of the provider.",
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			m, err := clusterMeta(d, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return f(ctx, d, m)
+		}
+	}
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			m, err := clusterMeta(d, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return f(ctx, d, m)
+		}
+	}
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			m, err := clusterMeta(d, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return f(ctx, d, m)
+		}
+	}
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			m, err := clusterMeta(d, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return f(ctx, d, m)
+		}
+	}
+
+	if dataSource {
+		return
+	}
+
+	// Reject unknown cluster names at plan time
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		m, err := clusterMeta(d, meta)
+		if err != nil {
+			return err
+		}
+		if customizeDiff == nil {
+			return nil
+		}
+		return customizeDiff(ctx, d, m)
+	}
+
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		f := r.Importer.StateContext
+		r.Importer = &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				name, id := clusterImportIdParts(d.Id())
+				if name != "" {
+					d.SetId(id)
+					if err := d.Set("cluster", name); err != nil {
+						return nil, err
+					}
+				}
+				m, err := clusterMeta(d, meta)
+				if err != nil {
+					return nil, err
+				}
+				return f(ctx, d, m)
+			},
+		}
+	}
+}
+
+func clusterMeta(d interface{ Get(string) interface{} }, meta interface{}) (interface{}, error) {
+	name, _ := d.Get("cluster").(string)
+	m, ok := meta.(KubeClientsets)
+	if name == "" || !ok {
+		return meta, nil
+	}
+	return m.ForCluster(name)
+}
+
+// clusterImportIdParts splits an import ID like "staging//default/nginx"
+// into the cluster name and the ID of the object.
+func clusterImportIdParts(id string) (string, string) {
+	parts := strings.SplitN(id, clusterImportIdSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || strings.Contains(parts[0], "/") {
+		return "", id
+	}
+	return parts[0], parts[1]
+}
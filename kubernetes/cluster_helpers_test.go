@@ -0,0 +1,28 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestClusterImportIdParts(t *testing.T) {
+	testCases := []struct {
+		Id       string
+		Cluster  string
+		Expected string
+	}{
+		{"default/nginx", "", "default/nginx"},
+		{"staging//default/nginx", "staging", "default/nginx"},
+		{"staging///cluster-admin", "staging", "/cluster-admin"},
+		{"staging//v1,ConfigMap,default,test", "staging", "v1,ConfigMap,default,test"},
+		{"rbac.authorization.k8s.io/v1,ClusterRole,,admin", "", "rbac.authorization.k8s.io/v1,ClusterRole,,admin"},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			cluster, id := clusterImportIdParts(tc.Id)
+			if cluster != tc.Cluster || id != tc.Expected {
+				t.Fatalf("Unexpected parts of %q: %q %q", tc.Id, cluster, id)
+			}
+		})
+	}
+}
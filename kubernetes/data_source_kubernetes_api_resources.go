@@ -0,0 +1,212 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+func dataSourceKubernetesAPIResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesAPIResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"group_versions": {
+				Type:        schema.TypeList,
+				Description: "All the group versions served by the API server, e.g. `v1` or `networking.k8s.io/v1`.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"api_group": {
+				Type:        schema.TypeList,
+				Description: "The API groups served by the API server. The core group has an empty name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the group.",
+							Computed:    true,
+						},
+						"versions": {
+							Type:        schema.TypeList,
+							Description: "Versions of the group served by the API server.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"preferred_version": {
+							Type:        schema.TypeString,
+							Description: "Version of the group preferred by the API server.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"resource": {
+				Type:        schema.TypeList,
+				Description: "The resources served by the API server, for each group version. Subresources are not listed.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:        schema.TypeString,
+							Description: "Group of the resource. The core group has an empty name.",
+							Computed:    true,
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Description: "Version of the resource.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Plural name of the resource, e.g. `deployments`.",
+							Computed:    true,
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "Kind of the resource, e.g. `Deployment`.",
+							Computed:    true,
+						},
+						"namespaced": {
+							Type:        schema.TypeBool,
+							Description: "Whether the resource is namespaced.",
+							Computed:    true,
+						},
+						"verbs": {
+							Type:        schema.TypeList,
+							Description: "Verbs supported by the resource, e.g. `get`, `list` or `watch`.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"short_names": {
+							Type:        schema.TypeList,
+							Description: "Short names of the resource, e.g. `deploy`.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesAPIResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	log.Printf("[INFO] Discovering API groups and resources")
+	groups, lists, err := conn.Discovery().ServerGroupsAndResources()
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			log.Printf("[DEBUG] Received error: %#v", err)
+			return diag.Errorf("Failed to discover API resources: %s", err)
+		}
+		// The resources of the groups which failed discovery are missing, the rest is still valid
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Some API groups failed discovery",
+			Detail:   fmt.Sprintf("Their resources are not listed: %s", err),
+		})
+	}
+
+	groupVersions := flattenAPIGroupVersions(groups)
+	err = d.Set("group_versions", groupVersions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("api_group", flattenAPIGroups(groups))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("resource", flattenAPIResourceLists(lists))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idsum := sha256.New()
+	_, err = idsum.Write([]byte(strings.Join(groupVersions, ",")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%x", idsum.Sum(nil)))
+
+	return diags
+}
+
+func flattenAPIGroupVersions(groups []*metav1.APIGroup) []string {
+	var gvs []string
+	for _, g := range groups {
+		for _, v := range g.Versions {
+			gvs = append(gvs, v.GroupVersion)
+		}
+	}
+	sort.Strings(gvs)
+	return gvs
+}
+
+func flattenAPIGroups(groups []*metav1.APIGroup) []interface{} {
+	att := make([]interface{}, 0, len(groups))
+	for _, g := range groups {
+		versions := make([]string, len(g.Versions))
+		for i, v := range g.Versions {
+			versions[i] = v.Version
+		}
+		att = append(att, map[string]interface{}{
+			"name":              g.Name,
+			"versions":          versions,
+			"preferred_version": g.PreferredVersion.Version,
+		})
+	}
+	sort.Slice(att, func(i, j int) bool {
+		return att[i].(map[string]interface{})["name"].(string) < att[j].(map[string]interface{})["name"].(string)
+	})
+	return att
+}
+
+func flattenAPIResourceLists(lists []*metav1.APIResourceList) []interface{} {
+	var att []interface{}
+	for _, l := range lists {
+		gv, err := apimachineryschema.ParseGroupVersion(l.GroupVersion)
+		if err != nil {
+			log.Printf("[WARN] Skipping resources of invalid group version %q: %s", l.GroupVersion, err)
+			continue
+		}
+		for _, r := range l.APIResources {
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+			att = append(att, map[string]interface{}{
+				"group":       gv.Group,
+				"version":     gv.Version,
+				"name":        r.Name,
+				"kind":        r.Kind,
+				"namespaced":  r.Namespaced,
+				"verbs":       []string(r.Verbs),
+				"short_names": r.ShortNames,
+			})
+		}
+	}
+	sort.SliceStable(att, func(i, j int) bool {
+		a, b := att[i].(map[string]interface{}), att[j].(map[string]interface{})
+		for _, k := range []string{"group", "version", "name"} {
+			if a[k].(string) != b[k].(string) {
+				return a[k].(string) < b[k].(string)
+			}
+		}
+		return false
+	})
+	return att
+}
@@ -0,0 +1,82 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesDataSourceAPIResources_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceAPIResourcesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("has_core_v1", "true"),
+					resource.TestCheckOutput("has_apps_v1", "true"),
+					resource.TestCheckOutput("deployments_namespaced", "true"),
+					resource.TestCheckOutput("nodes_namespaced", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceAPIResourcesConfig_basic() string {
+	return `data "kubernetes_api_resources" "test" {}
+
+locals {
+  resources = {
+    for r in data.kubernetes_api_resources.test.resource : "${r.group}/${r.version}/${r.name}" => r
+  }
+}
+
+output "has_core_v1" {
+  value = contains(data.kubernetes_api_resources.test.group_versions, "v1")
+}
+
+output "has_apps_v1" {
+  value = contains(data.kubernetes_api_resources.test.group_versions, "apps/v1")
+}
+
+output "deployments_namespaced" {
+  value = local.resources["apps/v1/deployments"].namespaced
+}
+
+output "nodes_namespaced" {
+  value = local.resources["/v1/nodes"].namespaced
+}
+`
+}
+
+func TestFlattenAPIResourceLists(t *testing.T) {
+	lists := []*metav1.APIResourceList{
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list"}, ShortNames: []string{"deploy"}},
+				{Name: "deployments/scale", Kind: "Scale", Namespaced: true, Verbs: []string{"get"}},
+			},
+		},
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+				{Name: "nodes", Kind: "Node", Verbs: []string{"get"}},
+			},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"group": "", "version": "v1", "name": "nodes", "kind": "Node", "namespaced": false, "verbs": []string{"get"}, "short_names": []string(nil)},
+		map[string]interface{}{"group": "", "version": "v1", "name": "pods", "kind": "Pod", "namespaced": true, "verbs": []string{"get"}, "short_names": []string(nil)},
+		map[string]interface{}{"group": "apps", "version": "v1", "name": "deployments", "kind": "Deployment", "namespaced": true, "verbs": []string{"get", "list"}, "short_names": []string{"deploy"}},
+	}
+	if out := flattenAPIResourceLists(lists); !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Unexpected resources, expected:\n%#v\ngiven:\n%#v", expected, out)
+	}
+}
@@ -0,0 +1,97 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesDeployments() *schema.Resource {
+	s := listSelectorFields("deployment", true)
+	s["deployments"] = listItemsSchema("deployment", true, map[string]*schema.Schema{
+		"spec": computedSchema(resourceKubernetesDeploymentSchemaV2()["spec"]),
+		"status": {
+			Type:        schema.TypeList,
+			Description: "Most recently observed status of the deployment.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"replicas": {
+						Type:        schema.TypeInt,
+						Description: "Total number of non-terminated pods targeted by this deployment.",
+						Computed:    true,
+					},
+					"updated_replicas": {
+						Type:        schema.TypeInt,
+						Description: "Total number of non-terminated pods targeted by this deployment that have the desired template spec.",
+						Computed:    true,
+					},
+					"ready_replicas": {
+						Type:        schema.TypeInt,
+						Description: "Total number of ready pods targeted by this deployment.",
+						Computed:    true,
+					},
+					"available_replicas": {
+						Type:        schema.TypeInt,
+						Description: "Total number of available pods targeted by this deployment, ready for at least min_ready_seconds.",
+						Computed:    true,
+					},
+					"unavailable_replicas": {
+						Type:        schema.TypeInt,
+						Description: "Total number of unavailable pods targeted by this deployment.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	})
+
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesDeploymentsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceKubernetesDeploymentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace := d.Get("namespace").(string)
+	log.Printf("[INFO] Listing deployments in namespace %q", namespace)
+	list, err := conn.AppsV1().Deployments(namespace).List(ctx, expandListOptions(d))
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to list deployments: %s", err)
+	}
+
+	deployments := make([]interface{}, len(list.Items))
+	items := make([]metav1.ObjectMeta, len(list.Items))
+	for i, dply := range list.Items {
+		prefix := fmt.Sprintf("deployments.%d.", i)
+		spec, err := flattenDeploymentSpec(dply.Spec, d, meta, prefix)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		deployments[i] = map[string]interface{}{
+			"metadata": flattenMetadata(dply.ObjectMeta, d, meta, prefix),
+			"spec":     spec,
+			"status":   flattenDeploymentStatus(dply.Status),
+		}
+		items[i] = dply.ObjectMeta
+	}
+	log.Printf("[INFO] Received %d deployments", len(deployments))
+
+	err = d.Set("deployments", deployments)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(listDataSourceId(d, items))
+
+	return nil
+}
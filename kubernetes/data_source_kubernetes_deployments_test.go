@@ -0,0 +1,84 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceDeployments_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceDeploymentsConfig_basic(name),
+			},
+			{
+				Config: testAccKubernetesDataSourceDeploymentsConfig_basic(name) +
+					testAccKubernetesDataSourceDeploymentsConfig_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_deployments.test", "deployments.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_deployments.test", "deployments.0.metadata.0.name", name),
+					resource.TestCheckResourceAttr("data.kubernetes_deployments.test", "deployments.0.spec.0.replicas", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_deployments.test", "deployments.0.spec.0.template.0.metadata.0.labels.app", name),
+					resource.TestCheckResourceAttr("data.kubernetes_deployments.test", "deployments.0.spec.0.template.0.spec.0.container.0.image", nginxImageVersion),
+					resource.TestCheckResourceAttr("data.kubernetes_deployments.test", "deployments.0.status.0.ready_replicas", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceDeploymentsConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+resource "kubernetes_deployment" "test" {
+  metadata {
+    name      = "%s"
+    namespace = kubernetes_namespace.test.metadata.0.name
+    labels = {
+      app = "%s"
+    }
+  }
+
+  spec {
+    replicas = 2
+    selector {
+      match_labels = {
+        app = "%s"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          app = "%s"
+        }
+      }
+      spec {
+        container {
+          image = "%s"
+          name  = "nginx"
+        }
+      }
+    }
+  }
+}
+`, name, name, name, name, name, nginxImageVersion)
+}
+
+func testAccKubernetesDataSourceDeploymentsConfig_read() string {
+	return `data "kubernetes_deployments" "test" {
+  namespace      = kubernetes_deployment.test.metadata.0.namespace
+  label_selector = "app=${kubernetes_deployment.test.metadata.0.labels.app}"
+}
+`
+}
@@ -5,13 +5,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	networking "k8s.io/api/networking/v1beta1"
+	networking "k8s.io/api/networking/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func dataSourceKubernetesIngress() *schema.Resource {
 	docHTTPIngressPath := networking.HTTPIngressPath{}.SwaggerDoc()
-	docHTTPIngressRuleValue := networking.HTTPIngressPath{}.SwaggerDoc()
+	docHTTPIngressRuleValue := networking.HTTPIngressRuleValue{}.SwaggerDoc()
 	docIngress := networking.Ingress{}.SwaggerDoc()
 	docIngressTLS := networking.IngressTLS{}.SwaggerDoc()
 	docIngressRule := networking.IngressRule{}.SwaggerDoc()
@@ -27,7 +27,12 @@ func dataSourceKubernetesIngress() *schema.Resource {
 				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"backend": backendSpecFields(defaultBackendDescription),
+						"ingress_class_name": {
+							Type:        schema.TypeString,
+							Description: docIngressSpec["ingressClassName"],
+							Computed:    true,
+						},
+						"backend": computedSchema(backendSpecFields(defaultBackendDescription)),
 						"rule": {
 							Type:        schema.TypeList,
 							Description: docIngressSpec["rules"],
@@ -56,7 +61,12 @@ func dataSourceKubernetesIngress() *schema.Resource {
 																Description: docHTTPIngressPath["path"],
 																Computed:    true,
 															},
-															"backend": backendSpecFields(ruleBackedDescription),
+															"path_type": {
+																Type:        schema.TypeString,
+																Description: docHTTPIngressPath["pathType"],
+																Computed:    true,
+															},
+															"backend": computedSchema(backendSpecFields(ruleBackedDescription)),
 														},
 													},
 												},
@@ -0,0 +1,231 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	authv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// defaultKubeconfigClusterName names the cluster entry of the top level provider connection
+const defaultKubeconfigClusterName = "default"
+
+func dataSourceKubernetesKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesKubeconfigRead,
+		Schema: map[string]*schema.Schema{
+			"context": {
+				Type:        schema.TypeList,
+				Description: "Contexts to render, each authenticating as a service account.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the context. Defaults to `<service_account_name>@<cluster>`.",
+							Optional:    true,
+						},
+						"service_account_name": {
+							Type:         schema.TypeString,
+							Description:  "Name of the service account the context authenticates as.",
+							Required:     true,
+							ValidateFunc: validateName,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "Namespace of the service account, also used as the default namespace of the context.",
+							Optional:    true,
+							Default:     "default",
+						},
+						"cluster": {
+							Type:        schema.TypeString,
+							Description: "Name of the provider `cluster` block to connect to. Defaults to the cluster selected by the data source.",
+							Optional:    true,
+						},
+						"expiration_seconds": {
+							Type:         schema.TypeInt,
+							Description:  "Requested validity of the token when it is obtained through the TokenRequest API, in seconds.",
+							Optional:     true,
+							Default:      3600,
+							ValidateFunc: validation.IntAtLeast(600),
+						},
+						"token": {
+							Type:        schema.TypeString,
+							Description: "Token the context authenticates with, e.g. from a `kubernetes_service_account_token` resource. When unset, the token is read from the token secret of the service account, or a new one is requested through the TokenRequest API every time the data source is read.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"current_context": {
+				Type:        schema.TypeString,
+				Description: "Name of the current context of the kubeconfig. Defaults to the first context.",
+				Optional:    true,
+			},
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Description: "The rendered kubeconfig in YAML format.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultClusterName := d.Get("cluster").(string)
+	if defaultClusterName == "" {
+		defaultClusterName = defaultKubeconfigClusterName
+	}
+
+	config := clientcmdapi.NewConfig()
+	for _, v := range d.Get("context").([]interface{}) {
+		c := v.(map[string]interface{})
+		name := c["service_account_name"].(string)
+		namespace := c["namespace"].(string)
+
+		clusterName := c["cluster"].(string)
+		clientsets := meta.(KubeClientsets)
+		if clusterName != "" {
+			var err error
+			clientsets, err = clientsets.ForCluster(clusterName)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			clusterName = defaultClusterName
+		}
+
+		contextName := c["name"].(string)
+		if contextName == "" {
+			contextName = fmt.Sprintf("%s@%s", name, clusterName)
+		}
+		if _, ok := config.Contexts[contextName]; ok {
+			return diag.Errorf("Duplicate context name %q", contextName)
+		}
+
+		if _, ok := config.Clusters[clusterName]; !ok {
+			cluster, err := kubeconfigCluster(clientsets.ClientConfig())
+			if err != nil {
+				return diag.Errorf("Failed to render cluster %q: %s", clusterName, err)
+			}
+			config.Clusters[clusterName] = cluster
+		}
+
+		token := c["token"].(string)
+		if token == "" {
+			conn, err := clientsets.MainClientset()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			token, err = serviceAccountKubeconfigToken(ctx, conn, namespace, name, int64(c["expiration_seconds"].(int)))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		config.AuthInfos[contextName] = &clientcmdapi.AuthInfo{Token: token}
+		config.Contexts[contextName] = &clientcmdapi.Context{
+			Cluster:   clusterName,
+			AuthInfo:  contextName,
+			Namespace: namespace,
+		}
+		if config.CurrentContext == "" {
+			config.CurrentContext = contextName
+		}
+	}
+
+	if v := d.Get("current_context").(string); v != "" {
+		if _, ok := config.Contexts[v]; !ok {
+			return diag.Errorf("Current context %q is not one of the rendered contexts", v)
+		}
+		config.CurrentContext = v
+	}
+
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return diag.Errorf("Failed to serialize kubeconfig: %s", err)
+	}
+	err = d.Set("kubeconfig", string(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(config.CurrentContext)
+
+	return nil
+}
+
+// kubeconfigCluster builds the kubeconfig cluster entry for the server and
+// certificate authority of a provider client configuration.
+func kubeconfigCluster(cfg *restclient.Config) (*clientcmdapi.Cluster, error) {
+	if cfg == nil || cfg.Host == "" {
+		return nil, fmt.Errorf("the provider configuration has no host")
+	}
+	server := cfg.Host
+	if !strings.Contains(server, "://") {
+		server = "https://" + server
+	}
+
+	cluster := &clientcmdapi.Cluster{
+		Server:                   server,
+		TLSServerName:            cfg.TLSClientConfig.ServerName,
+		InsecureSkipTLSVerify:    cfg.TLSClientConfig.Insecure,
+		CertificateAuthorityData: cfg.TLSClientConfig.CAData,
+	}
+	if len(cluster.CertificateAuthorityData) == 0 && cfg.TLSClientConfig.CAFile != "" {
+		ca, err := ioutil.ReadFile(cfg.TLSClientConfig.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		cluster.CertificateAuthorityData = ca
+	}
+	return cluster, nil
+}
+
+// serviceAccountKubeconfigToken returns the token of the legacy token secret of the service account,
+// or requests a bound token through the TokenRequest API when the cluster did not generate one.
+func serviceAccountKubeconfigToken(ctx context.Context, conn *kubernetes.Clientset, namespace, name string, expirationSeconds int64) (string, error) {
+	sa, err := conn.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("Unable to fetch service account %s/%s from Kubernetes: %s", namespace, name, err)
+	}
+
+	secretName, err := findDefaultServiceAccount(ctx, sa, conn)
+	if err != nil {
+		log.Printf("[DEBUG] No default token secret for service account %s/%s: %s", namespace, name, err)
+	}
+	if secretName != "" {
+		secret, err := conn.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("Unable to fetch secret %s/%s from Kubernetes: %s", namespace, secretName, err)
+		}
+		if token := secret.Data["token"]; len(token) > 0 {
+			return string(token), nil
+		}
+		log.Printf("[DEBUG] Secret %s/%s has no token yet", namespace, secretName)
+	}
+
+	log.Printf("[INFO] Requesting token for service account %s/%s", namespace, name)
+	req := &authv1.TokenRequest{
+		Spec: authv1.TokenRequestSpec{
+			ExpirationSeconds: ptrToInt64(expirationSeconds),
+		},
+	}
+	out, err := conn.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, name, req, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("Failed to request token for service account %s/%s: %s", namespace, name, err)
+	}
+	return out.Status.Token, nil
+}
@@ -0,0 +1,202 @@
+package kubernetes
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestAccKubernetesDataSourceKubeconfig_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceKubeconfigConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_kubeconfig.test", "id", "ci"),
+					resource.TestMatchResourceAttr("data.kubernetes_kubeconfig.test", "kubeconfig", regexp.MustCompile(`current-context: ci`)),
+					testAccCheckKubernetesKubeconfigContexts("data.kubernetes_kubeconfig.test", []string{"ci", name + "@default"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceKubeconfig_token(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceKubeconfigConfig_token(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesKubeconfigContexts("data.kubernetes_kubeconfig.test", []string{"ci"}),
+					testAccCheckKubernetesKubeconfigToken("data.kubernetes_kubeconfig.test", "ci", "kubernetes_service_account_token.test"),
+				),
+			},
+			{
+				// The supplied token does not change between runs
+				Config:   testAccKubernetesDataSourceKubeconfigConfig_token(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestKubeconfigCluster(t *testing.T) {
+	cases := []struct {
+		Input          *restclient.Config
+		ExpectedOutput *clientcmdapi.Cluster
+	}{
+		{
+			&restclient.Config{
+				Host: "https://127.0.0.1:6443",
+				TLSClientConfig: restclient.TLSClientConfig{
+					CAData:     []byte("ca"),
+					ServerName: "kubernetes",
+				},
+			},
+			&clientcmdapi.Cluster{
+				Server:                   "https://127.0.0.1:6443",
+				TLSServerName:            "kubernetes",
+				CertificateAuthorityData: []byte("ca"),
+			},
+		},
+		{
+			&restclient.Config{
+				Host: "127.0.0.1:6443",
+				TLSClientConfig: restclient.TLSClientConfig{
+					Insecure: true,
+				},
+			},
+			&clientcmdapi.Cluster{
+				Server:                "https://127.0.0.1:6443",
+				InsecureSkipTLSVerify: true,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := kubeconfigCluster(tc.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from kubeconfigCluster.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+
+	_, err := kubeconfigCluster(&restclient.Config{})
+	if err == nil {
+		t.Fatal("Expected an error for a configuration without host")
+	}
+}
+
+func testAccCheckKubernetesKubeconfigContexts(n string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		config, err := clientcmd.Load([]byte(rs.Primary.Attributes["kubeconfig"]))
+		if err != nil {
+			return fmt.Errorf("Rendered kubeconfig is invalid: %s", err)
+		}
+		if len(config.Contexts) != len(expected) {
+			return fmt.Errorf("Expected %d contexts, got %d", len(expected), len(config.Contexts))
+		}
+		for _, name := range expected {
+			c, ok := config.Contexts[name]
+			if !ok {
+				return fmt.Errorf("Context %q not found", name)
+			}
+			if config.AuthInfos[c.AuthInfo] == nil || config.AuthInfos[c.AuthInfo].Token == "" {
+				return fmt.Errorf("Context %q has no token", name)
+			}
+			if config.Clusters[c.Cluster] == nil || config.Clusters[c.Cluster].Server == "" {
+				return fmt.Errorf("Context %q has no server", name)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesKubeconfigToken(n, contextName, tokenResource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		tr, ok := s.RootModule().Resources[tokenResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", tokenResource)
+		}
+		config, err := clientcmd.Load([]byte(rs.Primary.Attributes["kubeconfig"]))
+		if err != nil {
+			return fmt.Errorf("Rendered kubeconfig is invalid: %s", err)
+		}
+		if config.AuthInfos[contextName] == nil || config.AuthInfos[contextName].Token != tr.Primary.Attributes["token"] {
+			return fmt.Errorf("Context %q does not use the token of %s", contextName, tokenResource)
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesDataSourceKubeconfigConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_service_account" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+data "kubernetes_kubeconfig" "test" {
+  context {
+    name                 = "ci"
+    service_account_name = kubernetes_service_account.test.metadata.0.name
+  }
+
+  context {
+    service_account_name = kubernetes_service_account.test.metadata.0.name
+  }
+
+  current_context = "ci"
+}
+`, name)
+}
+
+func testAccKubernetesDataSourceKubeconfigConfig_token(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_service_account" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+resource "kubernetes_service_account_token" "test" {
+  service_account_name = kubernetes_service_account.test.metadata.0.name
+}
+
+data "kubernetes_kubeconfig" "test" {
+  context {
+    name                 = "ci"
+    service_account_name = kubernetes_service_account.test.metadata.0.name
+    token                = kubernetes_service_account_token.test.token
+  }
+}
+`, name)
+}
@@ -0,0 +1,72 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesNodes() *schema.Resource {
+	s := listSelectorFields("node", false)
+	s["nodes"] = listItemsSchema("node", false, map[string]*schema.Schema{
+		"spec": {
+			Type:        schema.TypeList,
+			Description: "Spec of the node.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: nodeSpecFields(),
+			},
+		},
+		"status": {
+			Type:        schema.TypeList,
+			Description: "Most recently observed status of the node.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: nodeStatusFields(),
+			},
+		},
+	})
+
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesNodesRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceKubernetesNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Listing nodes")
+	list, err := conn.CoreV1().Nodes().List(ctx, expandListOptions(d))
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to list nodes: %s", err)
+	}
+
+	nodes := make([]interface{}, len(list.Items))
+	items := make([]metav1.ObjectMeta, len(list.Items))
+	for i, node := range list.Items {
+		nodes[i] = map[string]interface{}{
+			"metadata": flattenMetadata(node.ObjectMeta, d, meta, fmt.Sprintf("nodes.%d.", i)),
+			"spec":     flattenNodeSpec(node.Spec),
+			"status":   flattenNodeStatus(node.Status),
+		}
+		items[i] = node.ObjectMeta
+	}
+	log.Printf("[INFO] Received %d nodes", len(nodes))
+
+	err = d.Set("nodes", nodes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(listDataSourceId(d, items))
+
+	return nil
+}
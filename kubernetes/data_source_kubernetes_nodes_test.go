@@ -0,0 +1,37 @@
+package kubernetes
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceNodes_basic(t *testing.T) {
+	rxPosNum := regexp.MustCompile("^[1-9][0-9]*$")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceNodesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.kubernetes_nodes.test", "nodes.#", rxPosNum),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.metadata.0.name"),
+					resource.TestMatchResourceAttr("data.kubernetes_nodes.test", "nodes.0.status.0.addresses.#", rxPosNum),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.status.0.node_info.0.kubelet_version"),
+					resource.TestCheckResourceAttr("data.kubernetes_nodes.none", "nodes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceNodesConfig_basic() string {
+	return `data "kubernetes_nodes" "test" {}
+
+data "kubernetes_nodes" "none" {
+  label_selector = "tf-acc-test=none"
+}
+`
+}
@@ -0,0 +1,74 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesPods() *schema.Resource {
+	s := listSelectorFields("pod", true)
+	s["pods"] = listItemsSchema("pod", true, map[string]*schema.Schema{
+		"spec": {
+			Type:        schema.TypeList,
+			Description: "Specification of the desired behavior of the pod.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: computedSchemaFields(podSpecFields(false, false)),
+			},
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The phase of the pod.",
+			Computed:    true,
+		},
+	})
+
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesPodsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceKubernetesPodsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace := d.Get("namespace").(string)
+	log.Printf("[INFO] Listing pods in namespace %q", namespace)
+	list, err := conn.CoreV1().Pods(namespace).List(ctx, expandListOptions(d))
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to list pods: %s", err)
+	}
+
+	pods := make([]interface{}, len(list.Items))
+	items := make([]metav1.ObjectMeta, len(list.Items))
+	for i, pod := range list.Items {
+		spec, err := flattenPodSpec(pod.Spec)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		pods[i] = map[string]interface{}{
+			"metadata": flattenMetadata(pod.ObjectMeta, d, meta, fmt.Sprintf("pods.%d.", i)),
+			"spec":     spec,
+			"status":   string(pod.Status.Phase),
+		}
+		items[i] = pod.ObjectMeta
+	}
+	log.Printf("[INFO] Received %d pods", len(pods))
+
+	err = d.Set("pods", pods)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(listDataSourceId(d, items))
+
+	return nil
+}
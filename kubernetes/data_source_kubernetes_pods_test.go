@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourcePods_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourcePodsConfig_basic(name),
+			},
+			{
+				Config: testAccKubernetesDataSourcePodsConfig_basic(name) +
+					testAccKubernetesDataSourcePodsConfig_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.metadata.0.name", name),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.metadata.0.namespace", name),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.spec.0.container.0.image", busyboxImageVersion),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.status", "Running"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourcePodsConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+resource "kubernetes_pod" "test" {
+  metadata {
+    name      = "%s"
+    namespace = kubernetes_namespace.test.metadata.0.name
+    labels = {
+      app = "%s"
+    }
+  }
+
+  spec {
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["sleep", "3600"]
+    }
+  }
+}
+`, name, name, name, busyboxImageVersion)
+}
+
+func testAccKubernetesDataSourcePodsConfig_read() string {
+	return `data "kubernetes_pods" "test" {
+  namespace      = kubernetes_pod.test.metadata.0.namespace
+  label_selector = "app=${kubernetes_pod.test.metadata.0.labels.app}"
+  field_selector = "status.phase=Running"
+}
+`
+}
@@ -0,0 +1,86 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceKubernetesServerVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesServerVersionRead,
+		Schema: map[string]*schema.Schema{
+			"git_version": {
+				Type:        schema.TypeString,
+				Description: "Version of the API server, e.g. `v1.19.4`.",
+				Computed:    true,
+			},
+			"major": {
+				Type:        schema.TypeString,
+				Description: "Major version of the API server.",
+				Computed:    true,
+			},
+			"minor": {
+				Type:        schema.TypeString,
+				Description: "Minor version of the API server. Some distributions append a `+` to it.",
+				Computed:    true,
+			},
+			"platform": {
+				Type:        schema.TypeString,
+				Description: "Platform the API server runs on, e.g. `linux/amd64`.",
+				Computed:    true,
+			},
+			"git_commit": {
+				Type:        schema.TypeString,
+				Description: "Git commit the API server was built from.",
+				Computed:    true,
+			},
+			"build_date": {
+				Type:        schema.TypeString,
+				Description: "Date the API server was built.",
+				Computed:    true,
+			},
+			"go_version": {
+				Type:        schema.TypeString,
+				Description: "Version of Go the API server was built with.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesServerVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading server version")
+	v, err := conn.Discovery().ServerVersion()
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read server version: %s", err)
+	}
+	log.Printf("[INFO] Received server version: %#v", v)
+
+	attrs := map[string]string{
+		"git_version": v.GitVersion,
+		"major":       v.Major,
+		"minor":       v.Minor,
+		"platform":    v.Platform,
+		"git_commit":  v.GitCommit,
+		"build_date":  v.BuildDate,
+		"go_version":  v.GoVersion,
+	}
+	for k, value := range attrs {
+		err = d.Set(k, value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(v.GitVersion)
+
+	return nil
+}
@@ -48,8 +48,9 @@ func dataSourceKubernetesServiceAccount() *schema.Resource {
 				Computed:    true,
 			},
 			"default_secret_name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Description: "Name of the token secret created for the service account by the token controller. Empty on Kubernetes 1.24 and later.",
+				Computed:    true,
 			},
 		},
 	}
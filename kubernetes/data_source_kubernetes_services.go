@@ -0,0 +1,64 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesServices() *schema.Resource {
+	service := resourceKubernetesService().Schema
+	s := listSelectorFields("service", true)
+	s["services"] = listItemsSchema("service", true, map[string]*schema.Schema{
+		"spec":   computedSchema(service["spec"]),
+		"status": computedSchema(service["status"]),
+	})
+
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesServicesRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceKubernetesServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace := d.Get("namespace").(string)
+	log.Printf("[INFO] Listing services in namespace %q", namespace)
+	list, err := conn.CoreV1().Services(namespace).List(ctx, expandListOptions(d))
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to list services: %s", err)
+	}
+
+	services := make([]interface{}, len(list.Items))
+	items := make([]metav1.ObjectMeta, len(list.Items))
+	for i, svc := range list.Items {
+		services[i] = map[string]interface{}{
+			"metadata": flattenMetadata(svc.ObjectMeta, d, meta, fmt.Sprintf("services.%d.", i)),
+			"spec":     flattenServiceSpec(svc.Spec),
+			"status": []interface{}{
+				map[string][]interface{}{
+					"load_balancer": flattenLoadBalancerStatus(svc.Status.LoadBalancer),
+				},
+			},
+		}
+		items[i] = svc.ObjectMeta
+	}
+	log.Printf("[INFO] Received %d services", len(services))
+
+	err = d.Set("services", services)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(listDataSourceId(d, items))
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceServices_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceServicesConfig_basic(name),
+			},
+			{
+				Config: testAccKubernetesDataSourceServicesConfig_basic(name) +
+					testAccKubernetesDataSourceServicesConfig_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_services.test", "services.#", "2"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_services.test", "services.0.spec.0.cluster_ip"),
+					resource.TestCheckResourceAttr("data.kubernetes_services.test", "services.0.spec.0.port.0.port", "8080"),
+					resource.TestCheckResourceAttr("data.kubernetes_services.one", "services.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_services.one", "services.0.metadata.0.name", name+"-one"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceServicesConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+resource "kubernetes_service" "test" {
+  for_each = toset(["one", "two"])
+
+  metadata {
+    name      = "%s-${each.key}"
+    namespace = kubernetes_namespace.test.metadata.0.name
+  }
+
+  spec {
+    port {
+      port        = 8080
+      target_port = 80
+    }
+  }
+}
+`, name, name)
+}
+
+func testAccKubernetesDataSourceServicesConfig_read() string {
+	return `data "kubernetes_services" "test" {
+  namespace = kubernetes_service.test["one"].metadata.0.namespace
+}
+
+data "kubernetes_services" "one" {
+  namespace      = kubernetes_service.test["one"].metadata.0.namespace
+  field_selector = "metadata.name=${kubernetes_service.test["one"].metadata.0.name}"
+}
+`
+}
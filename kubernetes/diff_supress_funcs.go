@@ -16,3 +16,15 @@ func suppressEquivalentResourceQuantity(k, old, new string, d *schema.ResourceDa
 	}
 	return oldQ.Cmp(newQ) == 0
 }
+
+func suppressEquivalentManifestValue(k, old, new string, d *schema.ResourceData) bool {
+	oldM, err := normalizeManifestValue(old)
+	if err != nil {
+		return false
+	}
+	newM, err := normalizeManifestValue(new)
+	if err != nil {
+		return false
+	}
+	return oldM == newM
+}
@@ -0,0 +1,225 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	api "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+)
+
+// nodeDrainOptions mirrors the flags of `kubectl drain` supported by kubernetes_node_cordon
+type nodeDrainOptions struct {
+	ignoreDaemonSets   bool
+	deleteEmptyDirData bool
+	force              bool
+	gracePeriodSeconds int64
+	podSelector        string
+}
+
+func expandNodeDrainOptions(l []interface{}) *nodeDrainOptions {
+	if len(l) == 0 {
+		return nil
+	}
+	o := &nodeDrainOptions{gracePeriodSeconds: -1}
+	if l[0] == nil {
+		return o
+	}
+	m := l[0].(map[string]interface{})
+	if v, ok := m["ignore_daemonsets"].(bool); ok {
+		o.ignoreDaemonSets = v
+	}
+	if v, ok := m["delete_emptydir_data"].(bool); ok {
+		o.deleteEmptyDirData = v
+	}
+	if v, ok := m["force"].(bool); ok {
+		o.force = v
+	}
+	if v, ok := m["grace_period_seconds"].(int); ok {
+		o.gracePeriodSeconds = int64(v)
+	}
+	if v, ok := m["pod_selector"].(string); ok {
+		o.podSelector = v
+	}
+	return o
+}
+
+// drainNode evicts the pods running on the node through the eviction API, so
+// that PodDisruptionBudgets are respected, and waits for them to be gone.
+// The timeout applies to the whole drain.
+func drainNode(ctx context.Context, conn *kubernetes.Clientset, name string, o *nodeDrainOptions, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	gv, err := evictionGroupVersion(conn.Discovery())
+	if err != nil {
+		return err
+	}
+
+	list, err := conn.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{"spec.nodeName": name}).String(),
+		LabelSelector: o.podSelector,
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to list pods on node %s: %s", name, err)
+	}
+	pods, err := podsToEvict(list.Items, o)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Draining node %s: evicting %d pods", name, len(pods))
+
+	for _, pod := range pods {
+		err := evictPod(ctx, conn, gv, pod, o.gracePeriodSeconds, time.Until(deadline))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resource.RetryContext(ctx, time.Until(deadline), func() *resource.RetryError {
+		for _, pod := range pods {
+			p, err := conn.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return resource.NonRetryableError(err)
+			}
+			if p.UID == pod.UID {
+				return resource.RetryableError(fmt.Errorf("Pod %s/%s is still terminating", pod.Namespace, pod.Name))
+			}
+		}
+		return nil
+	})
+}
+
+// evictionGroupVersion returns the group version of the Eviction kind taken by the
+// pods/eviction subresource, policy/v1 is served from Kubernetes 1.22.
+func evictionGroupVersion(d discovery.DiscoveryInterface) (string, error) {
+	key := "pods/eviction"
+	if gv, ok := apiVersions.get(d, key); ok {
+		return gv, nil
+	}
+
+	list, err := d.ServerResourcesForGroupVersion("v1")
+	if err != nil {
+		return "", fmt.Errorf("Failed to discover the resources of v1: %s", err)
+	}
+	gv := evictionGroupVersionFromResources(list)
+	log.Printf("[INFO] Using %s for %s", gv, key)
+	apiVersions.set(d, key, gv)
+	return gv, nil
+}
+
+func evictionGroupVersionFromResources(list *metav1.APIResourceList) string {
+	for _, r := range list.APIResources {
+		if r.Name == "pods/eviction" && r.Group != "" && r.Version != "" {
+			return r.Group + "/" + r.Version
+		}
+	}
+	// Servers which do not report the kind only take policy/v1beta1
+	return "policy/v1beta1"
+}
+
+// evictPod asks for the pod to be evicted, retrying while a PodDisruptionBudget
+// does not allow the disruption. The Eviction has the same JSON representation
+// in policy/v1beta1 and policy/v1, it's sent in the group version gv.
+func evictPod(ctx context.Context, conn *kubernetes.Clientset, gv string, pod api.Pod, gracePeriodSeconds int64, timeout time.Duration) error {
+	eviction := &policy.Eviction{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gv,
+			Kind:       "Eviction",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+	}
+	if gracePeriodSeconds >= 0 {
+		eviction.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: &gracePeriodSeconds}
+	}
+	body, err := json.Marshal(eviction)
+	if err != nil {
+		return err
+	}
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		log.Printf("[INFO] Evicting pod %s/%s", pod.Namespace, pod.Name)
+		err := conn.CoreV1().RESTClient().Post().
+			Namespace(pod.Namespace).
+			Resource("pods").
+			Name(pod.Name).
+			SubResource("eviction").
+			SetHeader("Content-Type", "application/json").
+			Body(body).
+			Do(ctx).
+			Error()
+		switch {
+		case err == nil, errors.IsNotFound(err):
+			return nil
+		case errors.IsTooManyRequests(err):
+			return resource.RetryableError(fmt.Errorf("Cannot evict pod %s/%s as it would violate its disruption budget: %s", pod.Namespace, pod.Name, err))
+		default:
+			return resource.NonRetryableError(fmt.Errorf("Failed to evict pod %s/%s: %s", pod.Namespace, pod.Name, err))
+		}
+	})
+}
+
+// podsToEvict filters out the pods which a drain leaves on the node, and refuses
+// to drain when a pod would be lost for good without the matching option.
+func podsToEvict(pods []api.Pod, o *nodeDrainOptions) ([]api.Pod, error) {
+	var evict []api.Pod
+	var problems []string
+	for _, pod := range pods {
+		// Mirror pods are managed by the kubelet and cannot be evicted
+		if _, ok := pod.Annotations[api.MirrorPodAnnotationKey]; ok {
+			continue
+		}
+		// Pods which already finished do not hold anything on the node
+		if pod.Status.Phase == api.PodSucceeded || pod.Status.Phase == api.PodFailed {
+			evict = append(evict, pod)
+			continue
+		}
+		controller := metav1.GetControllerOf(&pod)
+		if controller != nil && controller.Kind == "DaemonSet" {
+			if o.ignoreDaemonSets {
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("%s/%s is managed by a DaemonSet, set ignore_daemonsets", pod.Namespace, pod.Name))
+			continue
+		}
+		if controller == nil && !o.force {
+			problems = append(problems, fmt.Sprintf("%s/%s is not managed by a controller, set force", pod.Namespace, pod.Name))
+			continue
+		}
+		if podHasEmptyDir(pod) && !o.deleteEmptyDirData {
+			problems = append(problems, fmt.Sprintf("%s/%s uses emptyDir volumes, set delete_emptydir_data", pod.Namespace, pod.Name))
+			continue
+		}
+		evict = append(evict, pod)
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("Cannot drain the node:\n   * %s", strings.Join(problems, "\n   * "))
+	}
+	return evict, nil
+}
+
+func podHasEmptyDir(pod api.Pod) bool {
+	for _, v := range pod.Spec.Volumes {
+		if v.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
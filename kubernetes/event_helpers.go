@@ -5,13 +5,28 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"time"
 
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 )
 
+// Time allowed to collect diagnostics once waiting for an object has failed
+const diagnosticsTimeout = 30 * time.Second
+
+// diagnosticsContext returns a context to collect diagnostics with after waiting
+// failed. When ctx expired, which is the case once the resource timeout is over,
+// the diagnostics get a short deadline of their own.
+func diagnosticsContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx.Err() == context.DeadlineExceeded {
+		ctx = context.Background()
+	}
+	return context.WithTimeout(ctx, diagnosticsTimeout)
+}
+
 func getLastWarningsForObject(ctx context.Context, conn *kubernetes.Clientset, metadata metav1.ObjectMeta, kind string, limit int) ([]api.Event, error) {
 	m := map[string]string{
 		"involvedObject.name": metadata.Name,
@@ -30,21 +45,53 @@ func getLastWarningsForObject(ctx context.Context, conn *kubernetes.Clientset, m
 		return nil, err
 	}
 
+	log.Printf("[DEBUG] Received %d events for %s/%s (%s)",
+		len(out.Items), metadata.Namespace, metadata.Name, kind)
+
+	return lastUniqueWarnings(out.Items, limit), nil
+}
+
+// getLastWarningsForObjects returns the latest warnings involving any of the given
+// objects, e.g. a workload together with the replica sets and pods it owns.
+func getLastWarningsForObjects(ctx context.Context, conn *kubernetes.Clientset, namespace string, uids []types.UID, limit int) ([]api.Event, error) {
+	fs := fields.OneTermEqualSelector("type", api.EventTypeWarning).String()
+	log.Printf("[DEBUG] Looking up events in %q via this selector: %q", namespace, fs)
+	out, err := conn.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	involved := make(map[types.UID]bool, len(uids))
+	for _, uid := range uids {
+		involved[uid] = true
+	}
+	var events []api.Event
+	for _, e := range out.Items {
+		if involved[e.InvolvedObject.UID] {
+			events = append(events, e)
+		}
+	}
+	log.Printf("[DEBUG] Received %d warnings for %d objects in %q", len(events), len(uids), namespace)
+
+	return lastUniqueWarnings(events, limit), nil
+}
+
+// lastUniqueWarnings returns up to limit warnings, latest first, skipping repeated messages.
+func lastUniqueWarnings(events []api.Event, limit int) []api.Event {
 	// It would be better to sort & filter on the server-side
 	// but API doesn't seem to support it
 	var warnings []api.Event
 
 	// Bring latest events to the top, for easy access
-	sort.Slice(out.Items, func(i, j int) bool {
-		return out.Items[i].LastTimestamp.After(out.Items[j].LastTimestamp.Time)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.After(events[j].LastTimestamp.Time)
 	})
 
-	log.Printf("[DEBUG] Received %d events for %s/%s (%s)",
-		len(out.Items), metadata.Namespace, metadata.Name, kind)
-
 	warnCount := 0
 	uniqueWarnings := make(map[string]api.Event, 0)
-	for _, e := range out.Items {
+	for _, e := range events {
 		if warnCount >= limit {
 			break
 		}
@@ -60,7 +107,7 @@ func getLastWarningsForObject(ctx context.Context, conn *kubernetes.Clientset, m
 		}
 	}
 
-	return warnings, nil
+	return warnings
 }
 
 func stringifyEvents(events []api.Event) string {
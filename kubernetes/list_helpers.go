@@ -0,0 +1,71 @@
+package kubernetes
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// listSelectorFields are the arguments of the data sources listing objects.
+func listSelectorFields(objectName string, namespaced bool) map[string]*schema.Schema {
+	fields := map[string]*schema.Schema{
+		"label_selector": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("A selector to restrict the list of returned %ss by their labels. Defaults to everything. More info: http://kubernetes.io/docs/user-guide/labels#label-selectors", objectName),
+			Optional:    true,
+		},
+		"field_selector": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("A selector to restrict the list of returned %ss by their fields. Defaults to everything. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/field-selectors/", objectName),
+			Optional:    true,
+		},
+	}
+	if namespaced {
+		fields["namespace"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("Namespace to list the %ss from. Defaults to all namespaces.", objectName),
+			Optional:    true,
+		}
+	}
+	return fields
+}
+
+// listItemsSchema describes the objects returned by a list data source.
+func listItemsSchema(objectName string, namespaced bool, fields map[string]*schema.Schema) *schema.Schema {
+	var metadata *schema.Schema
+	if namespaced {
+		metadata = namespacedMetadataSchema(objectName, false)
+	} else {
+		metadata = metadataSchema(objectName, false)
+	}
+	fields["metadata"] = computedSchema(metadata)
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("List of %ss matching the selectors.", objectName),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandListOptions(d *schema.ResourceData) metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: d.Get("label_selector").(string),
+		FieldSelector: d.Get("field_selector").(string),
+	}
+}
+
+// listDataSourceId identifies the result of a list data source by the selectors
+// and the objects returned, so that the ID changes along with them.
+func listDataSourceId(d *schema.ResourceData, items []metav1.ObjectMeta) string {
+	idsum := sha256.New()
+	fmt.Fprintf(idsum, "%v\n%v\n%v\n", d.Get("namespace"), d.Get("label_selector"), d.Get("field_selector"))
+	for _, v := range items {
+		fmt.Fprintf(idsum, "%s/%s\n", v.Namespace, v.Name)
+	}
+	return fmt.Sprintf("%x", idsum.Sum(nil))
+}
@@ -0,0 +1,145 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// Maximum number of remaining resources listed when a namespace is stuck terminating
+const namespaceRemainingResourcesLimit = 20
+
+// namespaceRemainingResource is an object still present in a terminating namespace
+type namespaceRemainingResource struct {
+	resource   schema.GroupResource
+	name       string
+	finalizers []string
+}
+
+func (r namespaceRemainingResource) String() string {
+	s := fmt.Sprintf("%s/%s", r.resource, r.name)
+	if len(r.finalizers) > 0 {
+		s += fmt.Sprintf(" (finalizers: %s)", strings.Join(r.finalizers, ", "))
+	}
+	return s
+}
+
+// namespaceDeletionDiagnostics explains why a namespace is stuck terminating,
+// listing its status conditions and the resources still in it.
+func namespaceDeletionDiagnostics(ctx context.Context, meta interface{}, name string) string {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		log.Printf("[WARN] Failed to collect namespace deletion diagnostics: %s", err)
+		return ""
+	}
+	ns, err := conn.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[WARN] Failed to read namespace for deletion diagnostics: %s", err)
+		return ""
+	}
+
+	remaining, err := namespaceRemainingResources(ctx, meta, name)
+	if err != nil {
+		log.Printf("[WARN] Failed to list remaining resources of namespace %s: %s", name, err)
+	}
+	return stringifyNamespaceConditions(ns) + stringifyNamespaceRemainingResources(remaining)
+}
+
+// namespaceRemainingResources lists the objects left in the namespace, looking
+// through all namespaced resources which the API server can list.
+func namespaceRemainingResources(ctx context.Context, meta interface{}, namespace string) ([]namespaceRemainingResource, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	client, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Groups which failed discovery are skipped, the others are still worth listing
+	lists, err := conn.Discovery().ServerPreferredNamespacedResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	if err != nil {
+		log.Printf("[WARN] Failed to discover some API groups: %s", err)
+	}
+
+	var remaining []namespaceRemainingResource
+	for _, l := range lists {
+		gv, err := schema.ParseGroupVersion(l.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range l.APIResources {
+			// Events outlive the objects they are about and never block deletion
+			if r.Name == "events" || !stringSliceContains(r.Verbs, "list") {
+				continue
+			}
+			out, err := client.Resource(gv.WithResource(r.Name)).Namespace(namespace).List(ctx, metav1.ListOptions{
+				Limit: namespaceRemainingResourcesLimit,
+			})
+			if err != nil {
+				log.Printf("[DEBUG] Failed to list %s in namespace %s: %s", r.Name, namespace, err)
+				continue
+			}
+			for _, item := range out.Items {
+				remaining = append(remaining, namespaceRemainingResource{
+					resource:   schema.GroupResource{Group: gv.Group, Resource: r.Name},
+					name:       item.GetName(),
+					finalizers: item.GetFinalizers(),
+				})
+			}
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		return remaining[i].String() < remaining[j].String()
+	})
+	return remaining, nil
+}
+
+func stringifyNamespaceConditions(ns *api.Namespace) string {
+	var output string
+	for _, c := range ns.Status.Conditions {
+		if c.Status != api.ConditionTrue {
+			continue
+		}
+		output += fmt.Sprintf("\n   * %s: %s: %s", c.Type, c.Reason, c.Message)
+	}
+	if output == "" {
+		return ""
+	}
+	return "\nConditions:" + output
+}
+
+func stringifyNamespaceRemainingResources(remaining []namespaceRemainingResource) string {
+	if len(remaining) == 0 {
+		return ""
+	}
+	output := fmt.Sprintf("\nRemaining resources (%d):", len(remaining))
+	for i, r := range remaining {
+		if i >= namespaceRemainingResourcesLimit {
+			output += fmt.Sprintf("\n   * ... and %d more", len(remaining)-i)
+			break
+		}
+		output += "\n   * " + r.String()
+	}
+	return output
+}
+
+func stringSliceContains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
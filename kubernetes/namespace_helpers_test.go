@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestStringifyNamespaceConditions(t *testing.T) {
+	ns := &api.Namespace{
+		Status: api.NamespaceStatus{
+			Phase: api.NamespaceTerminating,
+			Conditions: []api.NamespaceCondition{
+				{
+					Type:   api.NamespaceDeletionDiscoveryFailure,
+					Status: api.ConditionFalse,
+					Reason: "ResourcesDiscovered",
+				},
+				{
+					Type:    api.NamespaceContentRemaining,
+					Status:  api.ConditionTrue,
+					Reason:  "SomeResourcesRemain",
+					Message: "Some resources are remaining: configmaps. has 1 resource instances",
+				},
+				{
+					Type:    api.NamespaceFinalizersRemaining,
+					Status:  api.ConditionTrue,
+					Reason:  "SomeFinalizersRemain",
+					Message: "Some content in the namespace has finalizers remaining: example.com/cleanup in 1 resource instances",
+				},
+			},
+		},
+	}
+
+	expected := `
+Conditions:
+   * NamespaceContentRemaining: SomeResourcesRemain: Some resources are remaining: configmaps. has 1 resource instances
+   * NamespaceFinalizersRemaining: SomeFinalizersRemain: Some content in the namespace has finalizers remaining: example.com/cleanup in 1 resource instances`
+	if s := stringifyNamespaceConditions(ns); s != expected {
+		t.Fatalf("Unexpected conditions, expected:%s\n\ngiven:%s", expected, s)
+	}
+	if s := stringifyNamespaceConditions(&api.Namespace{}); s != "" {
+		t.Fatalf("Expected no conditions, given:%s", s)
+	}
+}
+
+func TestStringifyNamespaceRemainingResources(t *testing.T) {
+	remaining := []namespaceRemainingResource{
+		{
+			resource:   schema.GroupResource{Resource: "configmaps"},
+			name:       "settings",
+			finalizers: []string{"example.com/cleanup"},
+		},
+		{
+			resource: schema.GroupResource{Group: "example.com", Resource: "widgets"},
+			name:     "one",
+		},
+	}
+
+	expected := `
+Remaining resources (2):
+   * configmaps/settings (finalizers: example.com/cleanup)
+   * widgets.example.com/one`
+	if s := stringifyNamespaceRemainingResources(remaining); s != expected {
+		t.Fatalf("Unexpected remaining resources, expected:%s\n\ngiven:%s", expected, s)
+	}
+
+	remaining = nil
+	for i := 0; i < namespaceRemainingResourcesLimit+3; i++ {
+		remaining = append(remaining, namespaceRemainingResource{
+			resource: schema.GroupResource{Resource: "secrets"},
+			name:     fmt.Sprintf("secret-%d", i),
+		})
+	}
+	if s := stringifyNamespaceRemainingResources(remaining); !strings.HasSuffix(s, "... and 3 more") {
+		t.Fatalf("Expected the resources above the limit to be summarized, given:%s", s)
+	}
+}
@@ -1,6 +1,14 @@
 package kubernetes
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 var (
 	cascadeDeletePolicy = metav1.DeletePropagationForeground
@@ -8,3 +16,81 @@ var (
 		PropagationPolicy: &cascadeDeletePolicy,
 	}
 )
+
+// Resources whose destroy does not delete a Kubernetes object
+var resourcesWithoutDeletion = map[string]bool{
+	"kubernetes_node_cordon":           true,
+	"kubernetes_node_labels":           true,
+	"kubernetes_node_taint":            true,
+	"kubernetes_service_account_token": true,
+}
+
+func deletionPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Controls how the object is deleted when the resource is destroyed.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"abandon": {
+					Type:        schema.TypeBool,
+					Description: "Remove the resource from the Terraform state on destroy or replacement without deleting the object from the cluster.",
+					Optional:    true,
+					Default:     false,
+				},
+				"propagation_policy": {
+					Type:         schema.TypeString,
+					Description:  "Whether and how garbage collection is performed for the dependents of the object. One of `Foreground`, `Background` or `Orphan`. Defaults to the policy of the resource, or of the object kind on the server.",
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"Foreground", "Background", "Orphan"}, false),
+				},
+				"grace_period_seconds": {
+					Type:         schema.TypeInt,
+					Description:  "Duration in seconds before the object is deleted. Zero deletes immediately. If negative, the default grace period of the object kind is used.",
+					Optional:     true,
+					Default:      -1,
+					ValidateFunc: validation.IntAtLeast(-1),
+				},
+			},
+		},
+	}
+}
+
+// withDeletionPolicy adds the deletion_policy argument to a resource and
+// skips the deletion of the object when it is abandoned.
+func withDeletionPolicy(r *schema.Resource) {
+	r.Schema["deletion_policy"] = deletionPolicySchema()
+
+	f := r.DeleteContext
+	if f == nil {
+		return
+	}
+	r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if d.Get("deletion_policy.0.abandon").(bool) {
+			log.Printf("[INFO] Abandoning %s, removing it from state without deleting it", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+// expandDeleteOptions overrides the delete options of a resource with its deletion_policy.
+func expandDeleteOptions(d *schema.ResourceData, defaults metav1.DeleteOptions) metav1.DeleteOptions {
+	opts := defaults
+	l, ok := d.Get("deletion_policy").([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return opts
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["propagation_policy"].(string); ok && v != "" {
+		policy := metav1.DeletionPropagation(v)
+		opts.PropagationPolicy = &policy
+	}
+	if v, ok := in["grace_period_seconds"].(int); ok && v >= 0 {
+		opts.GracePeriodSeconds = ptrToInt64(int64(v))
+	}
+	return opts
+}
@@ -0,0 +1,174 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpandDeleteOptions(t *testing.T) {
+	background := metav1.DeletePropagationBackground
+	orphan := metav1.DeletePropagationOrphan
+
+	testCases := []struct {
+		Config   map[string]interface{}
+		Defaults metav1.DeleteOptions
+		Expected metav1.DeleteOptions
+	}{
+		{
+			map[string]interface{}{},
+			deleteOptions,
+			deleteOptions,
+		},
+		{
+			map[string]interface{}{
+				"deletion_policy": []interface{}{map[string]interface{}{"propagation_policy": "Orphan"}},
+			},
+			deleteOptions,
+			metav1.DeleteOptions{PropagationPolicy: &orphan},
+		},
+		{
+			map[string]interface{}{
+				"deletion_policy": []interface{}{map[string]interface{}{"grace_period_seconds": 0}},
+			},
+			metav1.DeleteOptions{},
+			metav1.DeleteOptions{GracePeriodSeconds: ptrToInt64(0)},
+		},
+		{
+			map[string]interface{}{
+				"deletion_policy": []interface{}{map[string]interface{}{
+					"propagation_policy":   "Background",
+					"grace_period_seconds": 30,
+				}},
+			},
+			metav1.DeleteOptions{},
+			metav1.DeleteOptions{PropagationPolicy: &background, GracePeriodSeconds: ptrToInt64(30)},
+		},
+	}
+
+	s := map[string]*schema.Schema{"deletion_policy": deletionPolicySchema()}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, s, tc.Config)
+			opts := expandDeleteOptions(d, tc.Defaults)
+
+			if derefPropagation(opts.PropagationPolicy) != derefPropagation(tc.Expected.PropagationPolicy) {
+				t.Fatalf("Unexpected propagation policy: %v, expected %v", derefPropagation(opts.PropagationPolicy), derefPropagation(tc.Expected.PropagationPolicy))
+			}
+			if (opts.GracePeriodSeconds == nil) != (tc.Expected.GracePeriodSeconds == nil) ||
+				(opts.GracePeriodSeconds != nil && *opts.GracePeriodSeconds != *tc.Expected.GracePeriodSeconds) {
+				t.Fatalf("Unexpected grace period: %v, expected %v", opts.GracePeriodSeconds, tc.Expected.GracePeriodSeconds)
+			}
+		})
+	}
+}
+
+func TestWithDeletionPolicy(t *testing.T) {
+	testCases := []struct {
+		Config  map[string]interface{}
+		Deleted bool
+	}{
+		{map[string]interface{}{}, true},
+		{map[string]interface{}{"deletion_policy": []interface{}{map[string]interface{}{"abandon": false}}}, true},
+		{map[string]interface{}{"deletion_policy": []interface{}{map[string]interface{}{"abandon": true}}}, false},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			deleted := false
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{},
+				DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					deleted = true
+					d.SetId("")
+					return nil
+				},
+			}
+			withDeletionPolicy(r)
+
+			d := schema.TestResourceDataRaw(t, r.Schema, tc.Config)
+			d.SetId("default/test")
+			diags := r.DeleteContext(context.Background(), d, nil)
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			if deleted != tc.Deleted {
+				t.Fatalf("Unexpected deletion: %t, expected %t", deleted, tc.Deleted)
+			}
+			if d.Id() != "" {
+				t.Fatalf("Expected the resource to be removed from state")
+			}
+		})
+	}
+}
+
+func TestWithDeletionPolicy_replace(t *testing.T) {
+	deleted, created := false, false
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			created = true
+			d.SetId(d.Get("name").(string))
+			return nil
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			deleted = true
+			d.SetId("")
+			return nil
+		},
+	}
+	withDeletionPolicy(r)
+
+	state := &terraform.InstanceState{
+		ID: "old",
+		Attributes: map[string]string{
+			"id":                        "old",
+			"name":                      "old",
+			"deletion_policy.#":         "1",
+			"deletion_policy.0.abandon": "true",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":            "new",
+		"deletion_policy": []interface{}{map[string]interface{}{"abandon": true}},
+	})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.RequiresNew() {
+		t.Fatalf("Expected the name change to replace the resource")
+	}
+
+	s, diags := r.Apply(context.Background(), state, diff, nil)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if deleted {
+		t.Fatalf("Expected the replaced object to be abandoned")
+	}
+	if !created || s.ID != "new" {
+		t.Fatalf("Expected the new object to be created, given state %#v", s)
+	}
+}
+
+func derefPropagation(p *metav1.DeletionPropagation) string {
+	if p == nil {
+		return ""
+	}
+	return string(*p)
+}
@@ -26,6 +26,17 @@ func diffStringMap(pathPrefix string, oldV, newV map[string]interface{}) PatchOp
 	// There may be some other map items managed outside of TF
 	// and we don't want to touch these.
 
+	return diffStringMapKeys(pathPrefix, oldV, newV)
+}
+
+// diffStringMapKeys only touches the keys present in either map, even when
+// the old one is empty, so it's suited for maps shared with other managers.
+// The map at pathPrefix must exist.
+func diffStringMapKeys(pathPrefix string, oldV, newV map[string]interface{}) PatchOperations {
+	ops := make([]PatchOperation, 0, 0)
+
+	pathPrefix = strings.TrimRight(pathPrefix, "/")
+
 	for k := range oldV {
 		if _, ok := newV[k]; ok {
 			continue
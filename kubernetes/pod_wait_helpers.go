@@ -0,0 +1,140 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "k8s.io/api/core/v1"
+)
+
+const (
+	podWaitForNone            = "none"
+	podWaitForPhase           = "phase"
+	podWaitForReady           = "ready"
+	podWaitForContainersReady = "containers_ready"
+)
+
+// Waiting reasons of containers which will not recover without a change to the pod
+var podContainerFailureReasons = []string{
+	"CrashLoopBackOff",
+	"ImagePullBackOff",
+}
+
+type podWaitFor struct {
+	mode   string
+	phases []api.PodPhase
+}
+
+func expandPodWaitFor(l []interface{}) podWaitFor {
+	w := podWaitFor{
+		mode:   podWaitForPhase,
+		phases: []api.PodPhase{api.PodRunning},
+	}
+	if len(l) == 0 || l[0] == nil {
+		return w
+	}
+	in := l[0].(map[string]interface{})
+	if v, ok := in["mode"].(string); ok && v != "" {
+		w.mode = v
+	}
+	if v, ok := in["phases"].(*schema.Set); ok && v.Len() > 0 {
+		w.phases = make([]api.PodPhase, 0, v.Len())
+		for _, p := range v.List() {
+			w.phases = append(w.phases, api.PodPhase(p.(string)))
+		}
+	}
+	return w
+}
+
+// podWaitConditionMet reports whether the pod reached the awaited state,
+// and returns an error once it never will.
+func podWaitConditionMet(pod *api.Pod, w podWaitFor) (bool, error) {
+	if err := podContainerFailure(pod); err != nil {
+		return false, err
+	}
+
+	phase := pod.Status.Phase
+	switch w.mode {
+	case podWaitForNone:
+		return true, nil
+	case podWaitForPhase:
+		for _, p := range w.phases {
+			if phase == p {
+				return true, nil
+			}
+		}
+	case podWaitForReady:
+		for _, c := range pod.Status.Conditions {
+			if c.Type == api.PodReady && c.Status == api.ConditionTrue {
+				return true, nil
+			}
+		}
+	case podWaitForContainersReady:
+		if len(pod.Status.ContainerStatuses) == len(pod.Spec.Containers) && allContainersReady(pod.Status.ContainerStatuses) {
+			return true, nil
+		}
+	default:
+		return false, fmt.Errorf("Unknown wait_for mode %q", w.mode)
+	}
+
+	if phase == api.PodSucceeded || phase == api.PodFailed {
+		return false, fmt.Errorf("Pod %s reached phase %s%s", pod.Name, phase, podStatusMessage(pod))
+	}
+	return false, nil
+}
+
+func allContainersReady(statuses []api.ContainerStatus) bool {
+	for _, s := range statuses {
+		if !s.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+// podContainerFailure returns an error describing the first container
+// of the pod stuck in one of podContainerFailureReasons.
+func podContainerFailure(pod *api.Pod) error {
+	statuses := append(append([]api.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, s := range statuses {
+		if s.State.Waiting == nil || !isPodContainerFailureReason(s.State.Waiting.Reason) {
+			continue
+		}
+		msg := fmt.Sprintf("Container %q of pod %s is in %s", s.Name, pod.Name, s.State.Waiting.Reason)
+		if s.State.Waiting.Message != "" {
+			msg += ": " + s.State.Waiting.Message
+		}
+		if t := s.LastTerminationState.Terminated; t != nil {
+			msg += fmt.Sprintf("\nLast termination: exit code %d, reason %s", t.ExitCode, t.Reason)
+			if m := strings.TrimSpace(t.Message); m != "" {
+				msg += ", message: " + m
+			}
+		}
+		return fmt.Errorf("%s", msg)
+	}
+	return nil
+}
+
+func isPodContainerFailureReason(reason string) bool {
+	for _, r := range podContainerFailureReasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func podStatusMessage(pod *api.Pod) string {
+	var parts []string
+	if pod.Status.Reason != "" {
+		parts = append(parts, pod.Status.Reason)
+	}
+	if pod.Status.Message != "" {
+		parts = append(parts, pod.Status.Message)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return ": " + strings.Join(parts, ": ")
+}
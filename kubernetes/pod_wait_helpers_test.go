@@ -0,0 +1,82 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodWaitConditionMet(t *testing.T) {
+	newPod := func(phase api.PodPhase, ready bool, containers ...api.ContainerStatus) *api.Pod {
+		pod := &api.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: api.PodSpec{
+				Containers: []api.Container{{Name: "one"}, {Name: "two"}},
+			},
+			Status: api.PodStatus{Phase: phase, ContainerStatuses: containers},
+		}
+		if ready {
+			pod.Status.Conditions = []api.PodCondition{{Type: api.PodReady, Status: api.ConditionTrue}}
+		}
+		return pod
+	}
+	running := func(name string, ready bool) api.ContainerStatus {
+		return api.ContainerStatus{Name: name, Ready: ready, State: api.ContainerState{Running: &api.ContainerStateRunning{}}}
+	}
+	crashing := api.ContainerStatus{
+		Name:  "two",
+		State: api.ContainerState{Waiting: &api.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 10s restarting failed container"}},
+		LastTerminationState: api.ContainerState{
+			Terminated: &api.ContainerStateTerminated{ExitCode: 1, Reason: "Error", Message: "config file not found\n"},
+		},
+	}
+
+	byPhase := func(phases ...api.PodPhase) podWaitFor {
+		return podWaitFor{mode: podWaitForPhase, phases: phases}
+	}
+
+	testCases := []struct {
+		Pod           *api.Pod
+		WaitFor       podWaitFor
+		Done          bool
+		ErrorContains string
+	}{
+		{newPod(api.PodPending, false), byPhase(api.PodRunning), false, ""},
+		{newPod(api.PodRunning, false), byPhase(api.PodRunning), true, ""},
+		{newPod(api.PodSucceeded, false), byPhase(api.PodRunning, api.PodSucceeded), true, ""},
+		{newPod(api.PodSucceeded, false), byPhase(api.PodRunning), false, "reached phase Succeeded"},
+		{newPod(api.PodRunning, false), podWaitFor{mode: podWaitForReady}, false, ""},
+		{newPod(api.PodRunning, true), podWaitFor{mode: podWaitForReady}, true, ""},
+		{newPod(api.PodRunning, false, running("one", true)), podWaitFor{mode: podWaitForContainersReady}, false, ""},
+		{newPod(api.PodRunning, false, running("one", true), running("two", false)), podWaitFor{mode: podWaitForContainersReady}, false, ""},
+		{newPod(api.PodRunning, false, running("one", true), running("two", true)), podWaitFor{mode: podWaitForContainersReady}, true, ""},
+		{newPod(api.PodPending, false), podWaitFor{mode: podWaitForNone}, true, ""},
+		{
+			newPod(api.PodRunning, false, running("one", true), crashing),
+			byPhase(api.PodRunning),
+			false,
+			`Container "two" of pod test is in CrashLoopBackOff: back-off 10s restarting failed container` +
+				"\nLast termination: exit code 1, reason Error, message: config file not found",
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			done, err := podWaitConditionMet(tc.Pod, tc.WaitFor)
+			if tc.ErrorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.ErrorContains) {
+					t.Fatalf("Expected error containing %q, given: %v", tc.ErrorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if done != tc.Done {
+				t.Fatalf("Expected done to be %t", tc.Done)
+			}
+		})
+	}
+}
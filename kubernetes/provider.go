@@ -8,14 +8,17 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
 	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	restclient "k8s.io/client-go/rest"
@@ -134,18 +137,89 @@ func Provider() *schema.Provider {
 				},
 				Description: "",
 			},
+			"qps": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBE_QPS", nil),
+				Description:  "Maximum number of requests per second sent to the Kubernetes API server, shared by all resources and data sources. Defaults to the client-go limit of 5.",
+				ValidateFunc: validatePositiveFloat,
+			},
+			"burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBE_BURST", nil),
+				Description:  "Maximum burst of requests sent to the Kubernetes API server above the `qps` limit. Defaults to the client-go limit of 10.",
+				ValidateFunc: validatePositiveInteger,
+			},
+			"apply_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBE_APPLY_MODE", "patch"),
+				Description:  "How resources are created and updated: `patch` sends JSON patches of the changed attributes, `server_side` uses server-side apply and tracks field ownership.",
+				ValidateFunc: validation.StringInSlice([]string{"patch", "server_side"}, false),
+			},
+			"field_manager": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_FIELD_MANAGER", "Terraform"),
+				Description: "Name of the field manager used with server-side apply.",
+			},
+			"force_conflicts": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_FORCE_CONFLICTS", false),
+				Description: "Take ownership of fields managed by other field managers when using server-side apply.",
+			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_ADOPT_EXISTING", false),
+				Description: "Adopt objects which already exist when creating resources, instead of failing. Can be overridden by the `adopt_existing` block of a resource.",
+			},
+			"adopt_ownership_label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBE_ADOPT_OWNERSHIP_LABEL", ""),
+				Description:  "Label selector, e.g. `app.kubernetes.io/managed-by=Helm`, which existing objects must match to be adopted.",
+				ValidateFunc: validateLabelSelectorString,
+			},
+			"cluster": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Additional named clusters, selected with the `cluster` argument of resources and data sources.",
+				Elem:        &schema.Resource{},
+			},
+			"ignore_annotations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+				Description: "List of regular expressions matching annotation keys managed outside of Terraform. Matching annotations are ignored on every resource and data source unless they are set in the configuration.",
+			},
+			"ignore_labels": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+				Description: "List of regular expressions matching label keys managed outside of Terraform. Matching labels are ignored on every resource and data source unless they are set in the configuration.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"kubernetes_all_namespaces":          dataSourceKubernetesAllNamespaces(),
+			"kubernetes_api_resources":           dataSourceKubernetesAPIResources(),
 			"kubernetes_config_map":              dataSourceKubernetesConfigMap(),
+			"kubernetes_deployments":             dataSourceKubernetesDeployments(),
 			"kubernetes_ingress":                 dataSourceKubernetesIngress(),
+			"kubernetes_kubeconfig":              dataSourceKubernetesKubeconfig(),
 			"kubernetes_namespace":               dataSourceKubernetesNamespace(),
+			"kubernetes_nodes":                   dataSourceKubernetesNodes(),
 			"kubernetes_secret":                  dataSourceKubernetesSecret(),
+			"kubernetes_server_version":          dataSourceKubernetesServerVersion(),
 			"kubernetes_service":                 dataSourceKubernetesService(),
 			"kubernetes_service_account":         dataSourceKubernetesServiceAccount(),
+			"kubernetes_services":                dataSourceKubernetesServices(),
 			"kubernetes_storage_class":           dataSourceKubernetesStorageClass(),
 			"kubernetes_pod":                     dataSourceKubernetesPod(),
+			"kubernetes_pods":                    dataSourceKubernetesPods(),
 			"kubernetes_persistent_volume_claim": dataSourceKubernetesPersistentVolumeClaim(),
 		},
 
@@ -163,10 +237,15 @@ func Provider() *schema.Provider {
 			"kubernetes_endpoints":                        resourceKubernetesEndpoints(),
 			"kubernetes_horizontal_pod_autoscaler":        resourceKubernetesHorizontalPodAutoscaler(),
 			"kubernetes_ingress":                          resourceKubernetesIngress(),
+			"kubernetes_ingress_class":                    resourceKubernetesIngressClass(),
 			"kubernetes_job":                              resourceKubernetesJob(),
 			"kubernetes_limit_range":                      resourceKubernetesLimitRange(),
+			"kubernetes_manifest":                         resourceKubernetesManifest(),
 			"kubernetes_namespace":                        resourceKubernetesNamespace(),
 			"kubernetes_network_policy":                   resourceKubernetesNetworkPolicy(),
+			"kubernetes_node_cordon":                      resourceKubernetesNodeCordon(),
+			"kubernetes_node_labels":                      resourceKubernetesNodeLabels(),
+			"kubernetes_node_taint":                       resourceKubernetesNodeTaint(),
 			"kubernetes_persistent_volume":                resourceKubernetesPersistentVolume(),
 			"kubernetes_persistent_volume_claim":          resourceKubernetesPersistentVolumeClaim(),
 			"kubernetes_pod":                              resourceKubernetesPod(),
@@ -177,9 +256,11 @@ func Provider() *schema.Provider {
 			"kubernetes_role_binding":                     resourceKubernetesRoleBinding(),
 			"kubernetes_resource_quota":                   resourceKubernetesResourceQuota(),
 			"kubernetes_role":                             resourceKubernetesRole(),
+			"kubernetes_runtime_class":                    resourceKubernetesRuntimeClass(),
 			"kubernetes_secret":                           resourceKubernetesSecret(),
 			"kubernetes_service":                          resourceKubernetesService(),
 			"kubernetes_service_account":                  resourceKubernetesServiceAccount(),
+			"kubernetes_service_account_token":            resourceKubernetesServiceAccountToken(),
 			"kubernetes_stateful_set":                     resourceKubernetesStatefulSet(),
 			"kubernetes_storage_class":                    resourceKubernetesStorageClass(),
 			"kubernetes_validating_webhook_configuration": resourceKubernetesValidatingWebhookConfiguration(),
@@ -187,6 +268,20 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	p.Schema["cluster"].Elem = clusterResource(p.Schema)
+	for name, r := range p.ResourcesMap {
+		if !resourcesWithoutDeletion[name] {
+			withDeletionPolicy(r)
+		}
+		if !resourcesWithoutCreation[name] {
+			withAdoption(r)
+		}
+		withClusterSelection(r, false)
+	}
+	for _, r := range p.DataSourcesMap {
+		withClusterSelection(r, true)
+	}
+
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return providerConfigure(ctx, d, p.TerraformVersion)
 	}
@@ -197,23 +292,39 @@ func Provider() *schema.Provider {
 type KubeClientsets interface {
 	MainClientset() (*kubernetes.Clientset, error)
 	AggregatorClientset() (*aggregator.Clientset, error)
+	DynamicClient() (dynamic.Interface, error)
+	ClientConfig() *restclient.Config
+	ApplyOptions() applyOptions
+	IgnoreAnnotations() []*regexp.Regexp
+	IgnoreLabels() []*regexp.Regexp
+	ForCluster(name string) (KubeClientsets, error)
 }
 
 type kubeClientsets struct {
+	// lock guards the clientsets, which are built on first use
+	lock                sync.Mutex
 	config              *restclient.Config
 	mainClientset       *kubernetes.Clientset
 	aggregatorClientset *aggregator.Clientset
+	dynamicClient       dynamic.Interface
+	applyOptions        applyOptions
+	ignoreAnnotations   []*regexp.Regexp
+	ignoreLabels        []*regexp.Regexp
+	clusters            *clusterRegistry
 
 	configData *schema.ResourceData
 }
 
-func (k kubeClientsets) MainClientset() (*kubernetes.Clientset, error) {
+func (k *kubeClientsets) MainClientset() (*kubernetes.Clientset, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
 	if k.mainClientset != nil {
 		return k.mainClientset, nil
 	}
 
 	if k.config != nil {
-		kc, err := kubernetes.NewForConfig(k.config)
+		kc, err := clientsets.mainClientset(k.config)
 		if err != nil {
 			return nil, fmt.Errorf("Failed to configure client: %s", err)
 		}
@@ -222,12 +333,15 @@ func (k kubeClientsets) MainClientset() (*kubernetes.Clientset, error) {
 	return k.mainClientset, nil
 }
 
-func (k kubeClientsets) AggregatorClientset() (*aggregator.Clientset, error) {
+func (k *kubeClientsets) AggregatorClientset() (*aggregator.Clientset, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
 	if k.aggregatorClientset != nil {
 		return k.aggregatorClientset, nil
 	}
 	if k.config != nil {
-		ac, err := aggregator.NewForConfig(k.config)
+		ac, err := clientsets.aggregatorClientset(k.config)
 		if err != nil {
 			return nil, fmt.Errorf("Failed to configure client: %s", err)
 		}
@@ -236,9 +350,68 @@ func (k kubeClientsets) AggregatorClientset() (*aggregator.Clientset, error) {
 	return k.aggregatorClientset, nil
 }
 
+// ClientConfig returns the client configuration the clientsets connect with.
+func (k *kubeClientsets) ClientConfig() *restclient.Config {
+	return k.config
+}
+
+func (k *kubeClientsets) ApplyOptions() applyOptions {
+	return k.applyOptions
+}
+
+func (k *kubeClientsets) IgnoreAnnotations() []*regexp.Regexp {
+	return k.ignoreAnnotations
+}
+
+func (k *kubeClientsets) IgnoreLabels() []*regexp.Regexp {
+	return k.ignoreLabels
+}
+
+// ForCluster returns the clientsets of the named provider cluster block,
+// or the default clientsets when name is empty.
+func (k *kubeClientsets) ForCluster(name string) (KubeClientsets, error) {
+	if name == "" {
+		return k, nil
+	}
+	if k.clusters == nil {
+		return nil, fmt.Errorf("Cluster %q is not defined in the provider configuration", name)
+	}
+	return k.clusters.get(name)
+}
+
+// withConfig returns clientsets sharing the provider settings of k
+// which connect with another client configuration.
+func (k *kubeClientsets) withConfig(cfg *restclient.Config) *kubeClientsets {
+	return &kubeClientsets{
+		config:            cfg,
+		applyOptions:      k.applyOptions,
+		ignoreAnnotations: k.ignoreAnnotations,
+		ignoreLabels:      k.ignoreLabels,
+		clusters:          k.clusters,
+		configData:        k.configData,
+	}
+}
+
+func (k *kubeClientsets) DynamicClient() (dynamic.Interface, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if k.dynamicClient != nil {
+		return k.dynamicClient, nil
+	}
+	if k.config != nil {
+		dc, err := clientsets.dynamicClient(k.config)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to configure dynamic client: %s", err)
+		}
+		k.dynamicClient = dc
+	}
+	return k.dynamicClient, nil
+}
+
 func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	// Config initialization
-	cfg, err := initializeConfiguration(d)
+	cfg, err := initializeConfiguration(d, "")
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
@@ -250,6 +423,42 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		cfg = &restclient.Config{}
 	}
 
+	configureTransport(cfg, terraformVersion)
+
+	ignoreAnnotations, err := expandRegexpList(d.Get("ignore_annotations").([]interface{}))
+	if err != nil {
+		return nil, diag.Errorf("Invalid ignore_annotations: %s", err)
+	}
+	ignoreLabels, err := expandRegexpList(d.Get("ignore_labels").([]interface{}))
+	if err != nil {
+		return nil, diag.Errorf("Invalid ignore_labels: %s", err)
+	}
+
+	clusters, err := expandClusters(d, terraformVersion)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	m := &kubeClientsets{
+		config: cfg,
+		applyOptions: applyOptions{
+			serverSide:     d.Get("apply_mode").(string) == "server_side",
+			fieldManager:   d.Get("field_manager").(string),
+			forceConflicts: d.Get("force_conflicts").(bool),
+
+			adoptExisting:       d.Get("adopt_existing").(bool),
+			adoptOwnershipLabel: d.Get("adopt_ownership_label").(string),
+		},
+		ignoreAnnotations: ignoreAnnotations,
+		ignoreLabels:      ignoreLabels,
+		clusters:          clusters,
+		configData:        d,
+	}
+	clusters.defaults = m
+	return m, diag.Diagnostics{}
+}
+
+func configureTransport(cfg *restclient.Config, terraformVersion string) {
 	cfg.UserAgent = fmt.Sprintf("HashiCorp/1.0 Terraform/%s", terraformVersion)
 
 	if logging.IsDebugOrHigher() {
@@ -258,29 +467,23 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 			return logging.NewTransport("Kubernetes", rt)
 		}
 	}
-
-	m := kubeClientsets{
-		config:              cfg,
-		mainClientset:       nil,
-		aggregatorClientset: nil,
-		configData:          d,
-	}
-	return m, diag.Diagnostics{}
 }
 
-func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error) {
+// initializeConfiguration builds the client configuration from the provider
+// attributes below prefix, e.g. "cluster.0." for a named cluster block.
+func initializeConfiguration(d *schema.ResourceData, prefix string) (*restclient.Config, error) {
 	overrides := &clientcmd.ConfigOverrides{}
 	loader := &clientcmd.ClientConfigLoadingRules{}
 
 	configPaths := []string{}
 
-	if v, ok := d.Get("config_path").(string); ok && v != "" {
+	if v, ok := d.Get(prefix + "config_path").(string); ok && v != "" {
 		configPaths = []string{v}
-	} else if v, ok := d.Get("config_paths").([]interface{}); ok && len(v) > 0 {
+	} else if v, ok := d.Get(prefix + "config_paths").([]interface{}); ok && len(v) > 0 {
 		for _, p := range v {
 			configPaths = append(configPaths, p.(string))
 		}
-	} else if v := os.Getenv("KUBE_CONFIG_PATHS"); v != "" {
+	} else if v := os.Getenv("KUBE_CONFIG_PATHS"); v != "" && prefix == "" {
 		// NOTE we have to do this here because the schema
 		// does not yet allow you to set a default for a TypeList
 		configPaths = filepath.SplitList(v)
@@ -309,9 +512,9 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error)
 
 		ctxSuffix := "; default context"
 
-		kubectx, ctxOk := d.GetOk("config_context")
-		authInfo, authInfoOk := d.GetOk("config_context_auth_info")
-		cluster, clusterOk := d.GetOk("config_context_cluster")
+		kubectx, ctxOk := d.GetOk(prefix + "config_context")
+		authInfo, authInfoOk := d.GetOk(prefix + "config_context_auth_info")
+		cluster, clusterOk := d.GetOk(prefix + "config_context_cluster")
 		if ctxOk || authInfoOk || clusterOk {
 			ctxSuffix = "; overriden context"
 			if ctxOk {
@@ -334,16 +537,16 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error)
 	}
 
 	// Overriding with static configuration
-	if v, ok := d.GetOk("insecure"); ok {
+	if v, ok := d.GetOk(prefix + "insecure"); ok {
 		overrides.ClusterInfo.InsecureSkipTLSVerify = v.(bool)
 	}
-	if v, ok := d.GetOk("cluster_ca_certificate"); ok {
+	if v, ok := d.GetOk(prefix + "cluster_ca_certificate"); ok {
 		overrides.ClusterInfo.CertificateAuthorityData = bytes.NewBufferString(v.(string)).Bytes()
 	}
-	if v, ok := d.GetOk("client_certificate"); ok {
+	if v, ok := d.GetOk(prefix + "client_certificate"); ok {
 		overrides.AuthInfo.ClientCertificateData = bytes.NewBufferString(v.(string)).Bytes()
 	}
-	if v, ok := d.GetOk("host"); ok {
+	if v, ok := d.GetOk(prefix + "host"); ok {
 		// Server has to be the complete address of the kubernetes cluster (scheme://hostname:port), not just the hostname,
 		// because `overrides` are processed too late to be taken into account by `defaultServerUrlFor()`.
 		// This basically replicates what defaultServerUrlFor() does with config but for overrides,
@@ -358,20 +561,20 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error)
 
 		overrides.ClusterInfo.Server = host.String()
 	}
-	if v, ok := d.GetOk("username"); ok {
+	if v, ok := d.GetOk(prefix + "username"); ok {
 		overrides.AuthInfo.Username = v.(string)
 	}
-	if v, ok := d.GetOk("password"); ok {
+	if v, ok := d.GetOk(prefix + "password"); ok {
 		overrides.AuthInfo.Password = v.(string)
 	}
-	if v, ok := d.GetOk("client_key"); ok {
+	if v, ok := d.GetOk(prefix + "client_key"); ok {
 		overrides.AuthInfo.ClientKeyData = bytes.NewBufferString(v.(string)).Bytes()
 	}
-	if v, ok := d.GetOk("token"); ok {
+	if v, ok := d.GetOk(prefix + "token"); ok {
 		overrides.AuthInfo.Token = v.(string)
 	}
 
-	if v, ok := d.GetOk("exec"); ok {
+	if v, ok := d.GetOk(prefix + "exec"); ok {
 		exec := &clientcmdapi.ExecConfig{}
 		if spec, ok := v.([]interface{})[0].(map[string]interface{}); ok {
 			exec.APIVersion = spec["api_version"].(string)
@@ -393,43 +596,28 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error)
 		return nil, nil
 	}
 
-	return cfg, nil
-}
-
-var useadmissionregistrationv1beta1 *bool
-
-func useAdmissionregistrationV1beta1(conn *kubernetes.Clientset) (bool, error) {
-	if useadmissionregistrationv1beta1 != nil {
-		return *useadmissionregistrationv1beta1, nil
-	}
-
-	d := conn.Discovery()
-
-	group := "admissionregistration.k8s.io"
-
-	v1, err := apimachineryschema.ParseGroupVersion(fmt.Sprintf("%s/v1", group))
-	if err != nil {
-		return false, err
+	// Named clusters use the rate limits of the provider unless they set their own.
+	// The limits must be positive, as client-go replaces 0 with its defaults.
+	if v, ok := d.GetOk(prefix + "qps"); ok {
+		cfg.QPS = float32(v.(float64))
+	} else if v, ok := d.GetOk("qps"); ok {
+		cfg.QPS = float32(v.(float64))
 	}
-
-	err = discovery.ServerSupportsVersion(d, v1)
-	if err == nil {
-		log.Printf("[INFO] Using %s/v1", group)
-		useadmissionregistrationv1beta1 = ptrToBool(false)
-		return false, nil
+	if v, ok := d.GetOk(prefix + "burst"); ok {
+		cfg.Burst = v.(int)
+	} else if v, ok := d.GetOk("burst"); ok {
+		cfg.Burst = v.(int)
 	}
 
-	v1beta1, err := apimachineryschema.ParseGroupVersion(fmt.Sprintf("%s/v1beta1", group))
-	if err != nil {
-		return false, err
-	}
+	return cfg, nil
+}
 
-	err = discovery.ServerSupportsVersion(d, v1beta1)
+func useAdmissionregistrationV1beta1(conn *kubernetes.Clientset) (bool, error) {
+	gv, err := negotiateAPIVersion(conn.Discovery(), "validatingwebhookconfigurations",
+		"admissionregistration.k8s.io/v1",
+		"admissionregistration.k8s.io/v1beta1")
 	if err != nil {
 		return false, err
 	}
-
-	log.Printf("[INFO] Using %s/v1beta1", group)
-	useadmissionregistrationv1beta1 = ptrToBool(true)
-	return true, nil
+	return gv == "admissionregistration.k8s.io/v1beta1", nil
 }
@@ -109,6 +109,85 @@ func TestProvider_configure_paths(t *testing.T) {
 	}
 }
 
+func TestProvider_configure_clusters(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host": "https://default.example.com",
+		"qps":  20,
+		"cluster": []interface{}{
+			map[string]interface{}{
+				"name": "staging",
+				"host": "https://staging.example.com",
+				"qps":  50,
+			},
+			map[string]interface{}{
+				"name":           "gcp",
+				"config_path":    "test-fixtures/kube-config.yaml",
+				"config_context": "gcp",
+			},
+		},
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	meta := p.Meta().(KubeClientsets)
+
+	testCases := map[string]struct {
+		Host string
+		QPS  float32
+	}{
+		"":        {"https://default.example.com", 20},
+		"staging": {"https://staging.example.com", 50},
+		"gcp":     {"https://127.0.0.1", 20},
+	}
+	for name, tc := range testCases {
+		c, err := meta.ForCluster(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h := c.(*kubeClientsets).config.Host; h != tc.Host {
+			t.Fatalf("Expected cluster %q to use host %q, given: %q", name, tc.Host, h)
+		}
+		if qps := c.(*kubeClientsets).config.QPS; qps != tc.QPS {
+			t.Fatalf("Expected cluster %q to use the QPS limit %v, given: %v", name, tc.QPS, qps)
+		}
+		again, err := meta.ForCluster(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again.(*kubeClientsets).config != c.(*kubeClientsets).config {
+			t.Fatalf("Expected the configuration of cluster %q to be cached", name)
+		}
+	}
+
+	if _, err := meta.ForCluster("production"); err == nil {
+		t.Fatal("Expected an undefined cluster to be rejected")
+	}
+}
+
+func TestProvider_configure_duplicateClusters(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster": []interface{}{
+			map[string]interface{}{"name": "staging", "host": "https://one.example.com"},
+			map[string]interface{}{"name": "staging", "host": "https://two.example.com"},
+		},
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if !diags.HasError() {
+		t.Fatal("Expected duplicate cluster names to be rejected")
+	}
+}
+
 func unsetEnv(t *testing.T) func() {
 	e := getEnv()
 
@@ -389,6 +468,20 @@ func getFirstNode() (api.Node, error) {
 	return resp.Items[0], nil
 }
 
+// testAccFirstNodeName returns the name of a node for the tests of the node
+// resources, whose configuration needs it before the test case runs.
+func testAccFirstNodeName(t *testing.T) string {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar)
+	}
+	testAccPreCheck(t)
+	node, err := getFirstNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return node.Name
+}
+
 func clusterVersionLessThan(vs string) bool {
 	cv, err := getClusterVersion()
 
@@ -106,14 +106,19 @@ func resourceKubernetesAPIServiceCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	svc := v1.APIService{
-		ObjectMeta: metadata,
-		Spec:       expandAPIServiceSpec(d.Get("spec").([]interface{})),
-	}
+	svc := expandAPIService(d)
 
 	log.Printf("[INFO] Creating new API service: %#v", svc)
-	out, err := conn.ApiregistrationV1().APIServices().Create(ctx, &svc, meta_v1.CreateOptions{})
+	out := svc
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.ApiregistrationV1().APIServices().Create(ctx, svc, meta_v1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = svc
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -123,6 +128,13 @@ func resourceKubernetesAPIServiceCreate(ctx context.Context, d *schema.ResourceD
 	return resourceKubernetesAPIServiceRead(ctx, d, meta)
 }
 
+func expandAPIService(d *schema.ResourceData) *v1.APIService {
+	return &v1.APIService{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandAPIServiceSpec(d.Get("spec").([]interface{})),
+	}
+}
+
 func resourceKubernetesAPIServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesAPIServiceExists(ctx, d, meta)
 	if err != nil {
@@ -144,7 +156,7 @@ func resourceKubernetesAPIServiceRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received API service: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -160,13 +172,22 @@ func resourceKubernetesAPIServiceRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceKubernetesAPIServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandAPIService(d), resourceKubernetesAPIServiceRead)
+	}
+
 	conn, err := meta.(KubeClientsets).AggregatorClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (meta_v1.Object, error) {
+		return conn.ApiregistrationV1().APIServices().Get(ctx, name, meta_v1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("spec") {
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
@@ -197,7 +218,7 @@ func resourceKubernetesAPIServiceDelete(ctx context.Context, d *schema.ResourceD
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting API service: %#v", name)
-	err = conn.ApiregistrationV1().APIServices().Delete(ctx, name, meta_v1.DeleteOptions{})
+	err = conn.ApiregistrationV1().APIServices().Delete(ctx, name, expandDeleteOptions(d, meta_v1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,26 +3,32 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"log"
+	"reflect"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"k8s.io/api/certificates/v1beta1"
+	certificates "k8s.io/api/certificates/v1"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/retry"
-	"log"
-	"reflect"
-	"time"
 )
 
 func resourceKubernetesCertificateSigningRequest() *schema.Resource {
-	apiDoc := v1beta1.CertificateSigningRequest{}.SwaggerDoc()
-	apiDocSpec := v1beta1.CertificateSigningRequestSpec{}.SwaggerDoc()
-	apiDocStatus := v1beta1.CertificateSigningRequestStatus{}.SwaggerDoc()
+	apiDoc := certificates.CertificateSigningRequest{}.SwaggerDoc()
+	apiDocSpec := certificates.CertificateSigningRequestSpec{}.SwaggerDoc()
+	apiDocStatus := certificates.CertificateSigningRequestStatus{}.SwaggerDoc()
 
 	return &schema.Resource{
 		CreateContext: resourceKubernetesCertificateSigningRequestCreate,
 		ReadContext:   resourceKubernetesCertificateSigningRequestRead,
+		UpdateContext: resourceKubernetesCertificateSigningRequestUpdate,
 		DeleteContext: resourceKubernetesCertificateSigningRequestDelete,
+		CustomizeDiff: resourceKubernetesCertificateSigningRequestCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -42,6 +48,32 @@ func resourceKubernetesCertificateSigningRequest() *schema.Resource {
 				Description: apiDocStatus["certificate"],
 				Computed:    true,
 			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Description: "Time from which the issued certificate is valid, in RFC3339 format.",
+				Computed:    true,
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Description: "Time after which the issued certificate is no longer valid, in RFC3339 format.",
+				Computed:    true,
+			},
+			"serial": {
+				Type:        schema.TypeString,
+				Description: "Serial number of the issued certificate, in decimal notation.",
+				Computed:    true,
+			},
+			"renew_before": {
+				Type:         schema.TypeString,
+				Description:  "How long before the issued certificate expires a new one is requested, e.g. `720h`. A plan proposes to replace the resource once this period starts. By default the certificate is only replaced once it has expired.",
+				Optional:     true,
+				ValidateFunc: validateDuration,
+			},
+			"ready_for_renewal": {
+				Type:        schema.TypeBool,
+				Description: "Whether the issued certificate expires within `renew_before`, or has expired.",
+				Computed:    true,
+			},
 			"metadata": metadataSchemaForceNew(metadataSchema("certificate signing request", true)),
 			"spec": {
 				ForceNew:    true,
@@ -60,12 +92,10 @@ func resourceKubernetesCertificateSigningRequest() *schema.Resource {
 						"signer_name": {
 							Type: schema.TypeString,
 							// no swagger doc available for signerName
-							Description: "Requested signer for the request. It is a qualified name in the form: `scope-hostname.io/name`." +
-								"If empty, it will be defaulted: 1. If it's a kubelet client certificate, it is assigned `kubernetes.io/kube-apiserver-client-kubelet`." +
-								"2. If it's a kubelet serving certificate, it is assigned `kubernetes.io/kubelet-serving`." +
-								"3. Otherwise, it is assigned `kubernetes.io/legacy-unknown`. Distribution of trust for signers happens out of band." +
-								"You can select on this field using `spec.signerName`.",
-							Optional: true,
+							Description: "Requested signer for the request. It is a qualified name in the form: `scope-hostname.io/name`. " +
+								"Well-known signers are `kubernetes.io/kube-apiserver-client`, `kubernetes.io/kube-apiserver-client-kubelet` and `kubernetes.io/kubelet-serving`, " +
+								"`kubernetes.io/legacy-unknown` is not accepted by the `certificates.k8s.io/v1` API. Distribution of trust for signers happens out of band.",
+							Required: true,
 							ForceNew: true,
 						},
 						"usages": {
@@ -83,8 +113,55 @@ func resourceKubernetesCertificateSigningRequest() *schema.Resource {
 	}
 }
 
+func resourceKubernetesCertificateSigningRequestCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("ready_for_renewal").(bool) {
+		return nil
+	}
+
+	log.Printf("[INFO] Certificate of %s is due for renewal (not after %s)", d.Id(), d.Get("not_after"))
+	err := d.SetNew("ready_for_renewal", false)
+	if err != nil {
+		return err
+	}
+	return d.ForceNew("ready_for_renewal")
+}
+
+// certificateSigningRequestClient uses certificates.k8s.io/v1 when the cluster serves it
+func certificateSigningRequestClient(meta interface{}) (*versionedResource, error) {
+	return newVersionedResource(meta, "certificatesigningrequests", "CertificateSigningRequest", "",
+		"certificates.k8s.io/v1", "certificates.k8s.io/v1beta1")
+}
+
+// doCertificateSigningRequest calls fn with the request converted to the negotiated
+// version, and converts the request fn decoded into out back to certificates.k8s.io/v1.
+func doCertificateSigningRequest(client *versionedResource, in *certificates.CertificateSigningRequest, fn func(in, out interface{}) error) (*certificates.CertificateSigningRequest, error) {
+	if client.GroupVersion() == "certificates.k8s.io/v1" {
+		out := &certificates.CertificateSigningRequest{}
+		if err := fn(in, out); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
+	var obj interface{}
+	if in != nil {
+		obj = certificateSigningRequestToV1beta1(in)
+	}
+	out := &certificatesv1beta1.CertificateSigningRequest{}
+	if err := fn(obj, out); err != nil {
+		return nil, err
+	}
+	return certificateSigningRequestFromV1beta1(out), nil
+}
+
+func getCertificateSigningRequest(ctx context.Context, client *versionedResource, name string) (*certificates.CertificateSigningRequest, error) {
+	return doCertificateSigningRequest(client, nil, func(_, out interface{}) error {
+		return client.Get(ctx, name, out)
+	})
+}
+
 func resourceKubernetesCertificateSigningRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	client, err := certificateSigningRequestClient(meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -95,42 +172,51 @@ func resourceKubernetesCertificateSigningRequestCreate(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 
-	csr := v1beta1.CertificateSigningRequest{
+	csr := certificates.CertificateSigningRequest{
 		ObjectMeta: metadata,
 		Spec:       *spec,
 	}
 	log.Printf("[INFO] Creating new certificate signing request: %#v", csr)
-	newCSR, createErr := conn.CertificatesV1beta1().CertificateSigningRequests().Create(ctx, &csr, metav1.CreateOptions{})
-	if createErr != nil {
+	newCSR, err := doCertificateSigningRequest(client, &csr, func(in, out interface{}) error {
+		if useServerSideApply(meta) {
+			return client.ApplyNew(ctx, d, meta, in, out)
+		}
+		err := client.Create(ctx, in, out)
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			return client.Adopt(ctx, d, meta, in, out)
+		}
+		return err
+	})
+	if err != nil {
 		return diag.Errorf("Failed to create certificate signing request: %s", err)
 	}
 
 	// Get the name, since it might have been randomly generated during create.
 	csrName := newCSR.ObjectMeta.Name
-
-	// Delete the remote CSR resource when this function exits, or when errors are encountered.
-	defer conn.CertificatesV1beta1().CertificateSigningRequests().Delete(ctx, csrName, metav1.DeleteOptions{})
+	d.SetId(csrName)
 
 	if d.Get("auto_approve").(bool) {
 		retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-			pendingCSR, getErr := conn.CertificatesV1beta1().CertificateSigningRequests().Get(ctx, csrName, metav1.GetOptions{})
+			pendingCSR, getErr := getCertificateSigningRequest(ctx, client, csrName)
 			if getErr != nil {
 				return getErr
 			}
-			approval := v1beta1.CertificateSigningRequestCondition{
-				Type:    "Approved",
+			approval := certificates.CertificateSigningRequestCondition{
+				Type:    certificates.CertificateApproved,
+				Status:  api.ConditionTrue,
 				Reason:  "TerraformAutoApprove",
 				Message: "This CSR was approved by Terraform auto_approve.",
 			}
-			pendingCSR.Status.Certificate = []byte{}
 			pendingCSR.Status.Conditions = append(pendingCSR.Status.Conditions, approval)
-			_, updateErr := conn.CertificatesV1beta1().CertificateSigningRequests().UpdateApproval(ctx, pendingCSR, metav1.UpdateOptions{})
+			_, updateErr := doCertificateSigningRequest(client, pendingCSR, func(in, out interface{}) error {
+				return client.Update(ctx, in, out, "approval")
+			})
 			return updateErr
 		})
 		if retryErr != nil {
-			panic(diag.Errorf("CSR auto-approve update failed: %v", retryErr))
+			return diag.Errorf("CSR auto-approve update failed: %v", retryErr)
 		}
-		fmt.Println("CSR auto-approve update succeeded")
+		log.Printf("[INFO] Approved certificate signing request: %s", csrName)
 	}
 
 	log.Printf("[DEBUG] Waiting for certificate to be issued")
@@ -139,14 +225,14 @@ func resourceKubernetesCertificateSigningRequestCreate(ctx context.Context, d *s
 		Pending: []string{"", "Approved"},
 		Timeout: d.Timeout(schema.TimeoutCreate),
 		Refresh: func() (interface{}, string, error) {
-			out, refreshErr := conn.CertificatesV1beta1().CertificateSigningRequests().Get(ctx, csrName, metav1.GetOptions{})
+			out, refreshErr := getCertificateSigningRequest(ctx, client, csrName)
 			if refreshErr != nil {
 				log.Printf("[ERROR] Received error: %v", refreshErr)
 				return out, "Error", refreshErr
 			}
 			var csrStatus string
-			emptyStatus := v1beta1.CertificateSigningRequestStatus{}
-			emptyCSR := v1beta1.CertificateSigningRequest{}
+			emptyStatus := certificates.CertificateSigningRequestStatus{}
+			emptyCSR := certificates.CertificateSigningRequest{}
 
 			// If the CSR is empty, check again later.
 			if reflect.DeepEqual(emptyCSR, out) {
@@ -162,7 +248,10 @@ func resourceKubernetesCertificateSigningRequestCreate(ctx context.Context, d *s
 			// since 'Issued' is not a state ever populated in the Status Conditions.
 			for _, condition := range out.Status.Conditions {
 				log.Printf("[DEBUG] Found Status.Condition.Type: %v", condition.Type)
-				if string(condition.Type) == "Approved" {
+				switch condition.Type {
+				case certificates.CertificateDenied, certificates.CertificateFailed:
+					return out, "Error", fmt.Errorf("Certificate signing request %s: %s: %s", condition.Type, condition.Reason, condition.Message)
+				case certificates.CertificateApproved:
 					if string(out.Status.Certificate) != "" {
 						log.Printf("[DEBUG] Found non-empty Certificate field in Status")
 						csrStatus = "Issued"
@@ -179,24 +268,89 @@ func resourceKubernetesCertificateSigningRequestCreate(ctx context.Context, d *s
 	}
 	log.Printf("[INFO] Certificate issued for request: %s", csrName)
 
-	issued, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(ctx, csrName, metav1.GetOptions{})
+	return resourceKubernetesCertificateSigningRequestRead(ctx, d, meta)
+}
+
+func resourceKubernetesCertificateSigningRequestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := certificateSigningRequestClient(meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(csrName)
-	d.Set("certificate", string(issued.Status.Certificate))
+	name := d.Id()
+	log.Printf("[INFO] Reading certificate signing request %s", name)
+	csr, err := getCertificateSigningRequest(ctx, client, name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.Printf("[DEBUG] Received error: %#v", err)
+			return diag.FromErr(err)
+		}
+		// The cluster garbage collects requests an hour after the certificate is issued,
+		// the certificate kept in the state stays valid until it expires.
+		if d.Get("certificate").(string) == "" {
+			log.Printf("[INFO] Certificate signing request %s not found and no certificate was issued, removing from state", name)
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		log.Printf("[INFO] Certificate signing request %s no longer exists, keeping the issued certificate", name)
+	} else {
+		log.Printf("[INFO] Received certificate signing request: %#v", csr)
+		err = d.Set("metadata", flattenMetadata(csr.ObjectMeta, d, meta))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = d.Set("spec", flattenCertificateSigningRequestSpec(csr.Spec))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(csr.Status.Certificate) > 0 {
+			d.Set("certificate", string(csr.Status.Certificate))
+		}
+	}
 
-	return resourceKubernetesCertificateSigningRequestRead(ctx, d, meta)
-}
+	certificate := d.Get("certificate").(string)
+	if certificate == "" {
+		return diag.Diagnostics{}
+	}
+	cert, err := parseCertificatePEM(certificate)
+	if err != nil {
+		return diag.Errorf("Failed to parse the issued certificate: %s", err)
+	}
+	d.Set("not_before", cert.NotBefore.UTC().Format(time.RFC3339))
+	d.Set("not_after", cert.NotAfter.UTC().Format(time.RFC3339))
+	d.Set("serial", cert.SerialNumber.String())
+
+	var renewBefore time.Duration
+	if v := d.Get("renew_before").(string); v != "" {
+		renewBefore, err = time.ParseDuration(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.Set("ready_for_renewal", certificateReadyForRenewal(cert, renewBefore, time.Now()))
 
-// resourceKubernetesCertificateSigningRequestRead does not return any data, because Read functions exist to
-// sync the local state with the remote state. Since this data is local-only, there is nothing to read.
-func resourceKubernetesCertificateSigningRequestRead(ctx context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	return diag.Diagnostics{}
 }
 
-func resourceKubernetesCertificateSigningRequestDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func resourceKubernetesCertificateSigningRequestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only renew_before can change without replacing the request
+	return resourceKubernetesCertificateSigningRequestRead(ctx, d, meta)
+}
+
+func resourceKubernetesCertificateSigningRequestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := certificateSigningRequestClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting certificate signing request: %#v", name)
+	err = client.Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
+	if err != nil && !errors.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Certificate signing request %s deleted", name)
+
 	d.SetId("")
 	return diag.Diagnostics{}
 }
@@ -3,28 +3,85 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestAccKubernetesCertificateSigningRequest_basic(t *testing.T) {
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	usages := []string{"client auth"}
-	signerName := "kubernetes.io/legacy-unknown"
+	signerName := "kubernetes.io/kube-apiserver-client"
+	resourceName := "kubernetes_certificate_signing_request.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
-		IDRefreshName:     "kubernetes_certificate_signing_request.test",
+		IDRefreshName:     resourceName,
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckKubernetesCertificateSigningRequestDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesCertificateSigningRequestConfig_basic(name, signerName, usages, true),
-				Check:  testAccCheckKubernetesCertificateSigningRequestValid,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCertificateSigningRequestValid,
+					resource.TestCheckResourceAttr(resourceName, "spec.0.signer_name", signerName),
+					resource.TestCheckResourceAttrSet(resourceName, "not_before"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial"),
+					resource.TestCheckResourceAttr(resourceName, "ready_for_renewal", "false"),
+				),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: name + "-missing",
+				ExpectError:   regexp.MustCompile("Cannot import non-existent remote object"),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesCertificateSigningRequest_renewBefore(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_certificate_signing_request.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCertificateSigningRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCertificateSigningRequestConfig_renewBefore(name, "1h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCertificateSigningRequestValid,
+					resource.TestCheckResourceAttr(resourceName, "renew_before", "1h"),
+					resource.TestCheckResourceAttr(resourceName, "ready_for_renewal", "false"),
+				),
+			},
+			{
+				// Cluster-issued certificates are valid for a year at most,
+				// so a ten year renewal period makes them due straight away.
+				Config:             testAccKubernetesCertificateSigningRequestConfig_renewBefore(name, "87600h"),
+				Check:              resource.TestCheckResourceAttr(resourceName, "ready_for_renewal", "true"),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKubernetesCertificateSigningRequest_signerNameRequired(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesCertificateSigningRequestConfig_noSignerName(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The argument "signer_name" is required`),
 			},
 		},
 	})
@@ -47,23 +104,35 @@ func TestAccKubernetesCertificateSigningRequest_generateName(t *testing.T) {
 }
 
 // testAccCheckKubernetesCertificateSigningRequestValid checks to see that the locally-stored certificate
-// contains a valid PEM preamble. It also checks that the CSR resource has been deleted from Kubernetes, since
-// the CSR is only supposed to exist momentarily as the certificate is generated. (CSR resources are ephemeral
-// in Kubernetes and therefore are only used temporarily).
+// contains a valid PEM preamble and matches the one issued for the CSR resource in Kubernetes.
 func testAccCheckKubernetesCertificateSigningRequestValid(s *terraform.State) error {
+	client, err := certificateSigningRequestClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type == "kubernetes_certificate_signing_request" {
-			if !strings.HasPrefix(rs.Primary.Attributes["certificate"], "-----BEGIN CERTIFICATE----") {
-				return fmt.Errorf("certificate is missing cert PEM preamble from resource: %s", rs.Primary.ID)
-			}
+		if rs.Type != "kubernetes_certificate_signing_request" {
+			continue
+		}
+		if !strings.HasPrefix(rs.Primary.Attributes["certificate"], "-----BEGIN CERTIFICATE----") {
+			return fmt.Errorf("certificate is missing cert PEM preamble from resource: %s", rs.Primary.ID)
+		}
+
+		out, err := getCertificateSigningRequest(ctx, client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if string(out.Status.Certificate) != rs.Primary.Attributes["certificate"] {
+			return fmt.Errorf("certificate in state does not match the one issued for: %s", rs.Primary.ID)
 		}
 	}
-	return testAccCheckKubernetesCertificateSigningRequestRemoteResourceDeleted(s)
+	return nil
 }
 
 func testAccCheckKubernetesCertificateSigningRequestRemoteResourceDeleted(s *terraform.State) error {
-	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-
+	client, err := certificateSigningRequestClient(testAccProvider.Meta())
 	if err != nil {
 		return err
 	}
@@ -74,7 +143,7 @@ func testAccCheckKubernetesCertificateSigningRequestRemoteResourceDeleted(s *ter
 			continue
 		}
 
-		out, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		out, err := getCertificateSigningRequest(ctx, client, rs.Primary.ID)
 		if err == nil {
 			if out.Name == rs.Primary.ID {
 				return fmt.Errorf("CertificateSigningRequest still exists in Kubernetes: %s", rs.Primary.ID)
@@ -127,9 +196,54 @@ BAMCA0AAMD0CHQDErNLjX86BVfOsYh/A4zmjmGknZpc2u6/coTHqAhxcR41hEU1I
 DpNPvh30e0Js8/DYn2YUfu/pQU19
 -----END CERTIFICATE REQUEST-----
 EOT
-    signer_name = "kubernetes.io/legacy-unknown"
+    signer_name = "kubernetes.io/kube-apiserver-client"
     usages      = ["client auth"]
   }
 }
 `, generateName)
 }
+
+func testAccKubernetesCertificateSigningRequestConfig_noSignerName(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_certificate_signing_request" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    request = <<EOT
+-----BEGIN CERTIFICATE REQUEST-----
+MIHSMIGBAgEAMCoxGDAWBgNVBAoTD2V4YW1wbGUgY2x1c3RlcjEOMAwGA1UEAxMF
+YWRtaW4wTjAQBgcqhkjOPQIBBgUrgQQAIQM6AASSG8S2+hQvfMq5ucngPCzK0m0C
+ImigHcF787djpF2QDbz3oQ3QsM/I7ftdjB/HHlG2a5YpqjzT0KAAMAoGCCqGSM49
+BAMCA0AAMD0CHQDErNLjX86BVfOsYh/A4zmjmGknZpc2u6/coTHqAhxcR41hEU1I
+DpNPvh30e0Js8/DYn2YUfu/pQU19
+-----END CERTIFICATE REQUEST-----
+EOT
+    usages  = ["client auth"]
+  }
+}
+`, name)
+}
+
+func testAccKubernetesCertificateSigningRequestConfig_renewBefore(name, renewBefore string) string {
+	return fmt.Sprintf(`resource "kubernetes_certificate_signing_request" "test" {
+  metadata {
+    name = "%s"
+  }
+  auto_approve = true
+  renew_before = "%s"
+  spec {
+    request     = <<EOT
+-----BEGIN CERTIFICATE REQUEST-----
+MIHSMIGBAgEAMCoxGDAWBgNVBAoTD2V4YW1wbGUgY2x1c3RlcjEOMAwGA1UEAxMF
+YWRtaW4wTjAQBgcqhkjOPQIBBgUrgQQAIQM6AASSG8S2+hQvfMq5ucngPCzK0m0C
+ImigHcF787djpF2QDbz3oQ3QsM/I7ftdjB/HHlG2a5YpqjzT0KAAMAoGCCqGSM49
+BAMCA0AAMD0CHQDErNLjX86BVfOsYh/A4zmjmGknZpc2u6/coTHqAhxcR41hEU1I
+DpNPvh30e0Js8/DYn2YUfu/pQU19
+-----END CERTIFICATE REQUEST-----
+EOT
+    signer_name = "kubernetes.io/kube-apiserver-client"
+    usages      = ["client auth"]
+  }
+}
+`, name, renewBefore)
+}
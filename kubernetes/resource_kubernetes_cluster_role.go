@@ -62,18 +62,19 @@ func resourceKubernetesClusterRoleCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	cRole := api.ClusterRole{
-		ObjectMeta: metadata,
-		Rules:      expandClusterRoleRules(d.Get("rule").([]interface{})),
-	}
-
-	if v, ok := d.GetOk("aggregation_rule"); ok {
-		cRole.AggregationRule = expandClusterRoleAggregationRule(v.([]interface{}))
-	}
+	cRole := expandClusterRole(d)
 
 	log.Printf("[INFO] Creating new cluster role: %#v", cRole)
-	out, err := conn.RbacV1().ClusterRoles().Create(ctx, &cRole, metav1.CreateOptions{})
+	out := cRole
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.RbacV1().ClusterRoles().Create(ctx, cRole, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = cRole
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -84,13 +85,22 @@ func resourceKubernetesClusterRoleCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubernetesClusterRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandClusterRole(d), resourceKubernetesClusterRoleRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.RbacV1().ClusterRoles().Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("rule") {
 		diffOps := patchRbacRule(d)
 		ops = append(ops, diffOps...)
@@ -114,6 +124,18 @@ func resourceKubernetesClusterRoleUpdate(ctx context.Context, d *schema.Resource
 	return resourceKubernetesClusterRoleRead(ctx, d, meta)
 }
 
+func expandClusterRole(d *schema.ResourceData) *api.ClusterRole {
+	cRole := &api.ClusterRole{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Rules:      expandClusterRoleRules(d.Get("rule").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("aggregation_rule"); ok {
+		cRole.AggregationRule = expandClusterRoleAggregationRule(v.([]interface{}))
+	}
+	return cRole
+}
+
 func resourceKubernetesClusterRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesClusterRoleExists(ctx, d, meta)
 	if err != nil {
@@ -140,7 +162,7 @@ func resourceKubernetesClusterRoleRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received cluster role: %#v", cRole)
-	err = d.Set("metadata", flattenMetadata(cRole.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(cRole.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -165,7 +187,7 @@ func resourceKubernetesClusterRoleDelete(ctx context.Context, d *schema.Resource
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting cluster role: %#v", name)
-	err = conn.RbacV1().ClusterRoles().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.RbacV1().ClusterRoles().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -53,24 +53,36 @@ func resourceKubernetesClusterRoleBindingCreate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	binding := &api.ClusterRoleBinding{
-		ObjectMeta: metadata,
-		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
-		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
-	}
+	binding := expandClusterRoleBinding(d)
 	log.Printf("[INFO] Creating new ClusterRoleBinding: %#v", binding)
-	binding, err = conn.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{})
+	out := binding
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = binding
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	log.Printf("[INFO] Submitted new ClusterRoleBinding: %#v", binding)
-	d.SetId(metadata.Name)
+	log.Printf("[INFO] Submitted new ClusterRoleBinding: %#v", out)
+	d.SetId(binding.Name)
 
 	return resourceKubernetesClusterRoleBindingRead(ctx, d, meta)
 }
 
+func expandClusterRoleBinding(d *schema.ResourceData) *api.ClusterRoleBinding {
+	return &api.ClusterRoleBinding{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
+		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
+	}
+}
+
 func resourceKubernetesClusterRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesClusterRoleBindingExists(ctx, d, meta)
 	if err != nil {
@@ -93,7 +105,7 @@ func resourceKubernetesClusterRoleBindingRead(ctx context.Context, d *schema.Res
 	}
 
 	log.Printf("[INFO] Received ClusterRoleBinding: %#v", binding)
-	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -116,6 +128,10 @@ func resourceKubernetesClusterRoleBindingRead(ctx context.Context, d *schema.Res
 }
 
 func resourceKubernetesClusterRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandClusterRoleBinding(d), resourceKubernetesClusterRoleBindingRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -123,7 +139,12 @@ func resourceKubernetesClusterRoleBindingUpdate(ctx context.Context, d *schema.R
 
 	name := d.Id()
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.RbacV1().ClusterRoleBindings().Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("subject") {
 		diffOps := patchRbacSubject(d)
 		ops = append(ops, diffOps...)
@@ -151,7 +172,7 @@ func resourceKubernetesClusterRoleBindingDelete(ctx context.Context, d *schema.R
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting ClusterRoleBinding: %#v", name)
-	err = conn.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.RbacV1().ClusterRoleBindings().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -45,14 +45,18 @@ func resourceKubernetesConfigMapCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	cfgMap := api.ConfigMap{
-		ObjectMeta: metadata,
-		BinaryData: expandBase64MapToByteMap(d.Get("binary_data").(map[string]interface{})),
-		Data:       expandStringMap(d.Get("data").(map[string]interface{})),
-	}
+	cfgMap := expandConfigMap(d)
 	log.Printf("[INFO] Creating new config map: %#v", cfgMap)
-	out, err := conn.CoreV1().ConfigMaps(metadata.Namespace).Create(ctx, &cfgMap, metav1.CreateOptions{})
+	out := cfgMap
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().ConfigMaps(cfgMap.Namespace).Create(ctx, cfgMap, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = cfgMap
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -62,6 +66,14 @@ func resourceKubernetesConfigMapCreate(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesConfigMapRead(ctx, d, meta)
 }
 
+func expandConfigMap(d *schema.ResourceData) *api.ConfigMap {
+	return &api.ConfigMap{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		BinaryData: expandBase64MapToByteMap(d.Get("binary_data").(map[string]interface{})),
+		Data:       expandStringMap(d.Get("data").(map[string]interface{})),
+	}
+}
+
 func resourceKubernetesConfigMapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesConfigMapExists(ctx, d, meta)
 	if err != nil {
@@ -86,7 +98,7 @@ func resourceKubernetesConfigMapRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received config map: %#v", cfgMap)
-	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -98,6 +110,10 @@ func resourceKubernetesConfigMapRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesConfigMapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandConfigMap(d), resourceKubernetesConfigMapRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -108,7 +124,12 @@ func resourceKubernetesConfigMapUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("binary_data") {
 		oldV, newV := d.GetChange("binary_data")
 		diffOps := diffStringMap("/binaryData/", oldV.(map[string]interface{}), newV.(map[string]interface{}))
@@ -148,7 +169,7 @@ func resourceKubernetesConfigMapDelete(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Deleting config map: %#v", name)
-	err = conn.CoreV1().ConfigMaps(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().ConfigMaps(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
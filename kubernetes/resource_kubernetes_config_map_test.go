@@ -186,6 +186,115 @@ func TestAccKubernetesConfigMap_generatedName(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesConfigMap_serverSideApply(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_config_map.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_serverSideApply() + testAccKubernetesConfigMapConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists(resourceName, &conf),
+					testAccCheckConfigMapData(&conf, map[string]string{"one": "first", "two": "second"}),
+					testAccCheckKubernetesConfigMapFieldManager(&conf, "Terraform"),
+				),
+			},
+			{
+				Config: testAccKubernetesConfigMapConfig_serverSideApply() + testAccKubernetesConfigMapConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists(resourceName, &conf),
+					testAccCheckConfigMapData(&conf, map[string]string{"one": "first", "two": "second", "nine": "ninth"}),
+					testAccCheckKubernetesConfigMapFieldManager(&conf, "Terraform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesConfigMap_abandon(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_config_map.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_abandon(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "deletion_policy.0.abandon", "true"),
+				),
+			},
+			{
+				Config: testAccKubernetesConfigMapConfig_abandoned(),
+				Check:  testAccCheckKubernetesConfigMapAbandoned("default", name),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesConfigMap_adoptExisting(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_config_map.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+					if err != nil {
+						t.Fatal(err)
+					}
+					cfgMap := &api.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+							Labels:    map[string]string{"app.kubernetes.io/managed-by": "kubectl"},
+						},
+						Data: map[string]string{"one": "outdated"},
+					}
+					_, err = conn.CoreV1().ConfigMaps("default").Create(context.TODO(), cfgMap, metav1.CreateOptions{})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccKubernetesConfigMapConfig_adoptExisting(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists(resourceName, &conf),
+					testAccCheckConfigMapData(&conf, map[string]string{"one": "first", "two": "second"}),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data.one", "first"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesConfigMapFieldManager(m *api.ConfigMap, manager string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, f := range m.ManagedFields {
+			if f.Manager == manager && f.Operation == metav1.ManagedFieldsOperationApply {
+				return nil
+			}
+		}
+		return fmt.Errorf("Expected config map to have fields applied by %q, given: %#v", manager, m.ManagedFields)
+	}
+}
+
 func testAccCheckConfigMapData(m *api.ConfigMap, expected map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(expected) == 0 && len(m.Data) == 0 {
@@ -253,6 +362,24 @@ func testAccCheckKubernetesConfigMapExists(n string, obj *api.ConfigMap) resourc
 	}
 }
 
+// testAccCheckKubernetesConfigMapAbandoned checks that the config map outlived
+// its resource, and deletes it.
+func testAccCheckKubernetesConfigMapAbandoned(namespace, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		_, err = conn.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("Expected abandoned config map %s/%s to exist: %s", namespace, name, err)
+		}
+		return conn.CoreV1().ConfigMaps(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	}
+}
+
 func testAccKubernetesConfigMapConfig_nodata(name string) string {
 	return fmt.Sprintf(`resource "kubernetes_config_map" "test" {
   metadata {
@@ -334,6 +461,14 @@ func testAccKubernetesConfigMapConfig_noData(name string) string {
 `, name)
 }
 
+func testAccKubernetesConfigMapConfig_serverSideApply() string {
+	return `provider "kubernetes" {
+  apply_mode = "server_side"
+}
+
+`
+}
+
 func testAccKubernetesConfigMapConfig_generatedName(prefix string) string {
 	return fmt.Sprintf(`resource "kubernetes_config_map" "test" {
   metadata {
@@ -383,3 +518,45 @@ func testAccKubernetesConfigMapConfig_binaryData2(prefix string) string {
 }
 `, prefix)
 }
+
+func testAccKubernetesConfigMapConfig_abandon(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_config_map" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  data = {
+    one = "first"
+  }
+
+  deletion_policy {
+    abandon = true
+  }
+}
+`, name)
+}
+
+func testAccKubernetesConfigMapConfig_abandoned() string {
+	return `locals {
+  abandoned = true
+}
+`
+}
+
+func testAccKubernetesConfigMapConfig_adoptExisting(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_config_map" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  data = {
+    one = "first"
+    two = "second"
+  }
+
+  adopt_existing {
+    ownership_label = "app.kubernetes.io/managed-by=kubectl"
+  }
+}
+`, name)
+}
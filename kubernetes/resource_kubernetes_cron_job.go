@@ -33,13 +33,18 @@ func resourceKubernetesCronJob() *schema.Resource {
 				Type:    resourceKubernetesCronJobV0().CoreConfigSchema().ImpliedType(),
 				Upgrade: resourceKubernetesCronJobUpgradeV0,
 			},
+			{
+				Version: 1,
+				Type:    resourceKubernetesCronJobV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKubernetesCronJobUpgradeV1,
+			},
 		},
-		SchemaVersion: 1,
-		Schema:        resourceKubernetesCronJobSchemaV1(),
+		SchemaVersion: 2,
+		Schema:        resourceKubernetesCronJobSchemaV2(),
 	}
 }
 
-func resourceKubernetesCronJobSchemaV1() map[string]*schema.Schema {
+func resourceKubernetesCronJobSchemaV2() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"metadata": namespacedMetadataSchema("cronjob", true),
 		"spec": {
@@ -54,12 +59,12 @@ func resourceKubernetesCronJobSchemaV1() map[string]*schema.Schema {
 	}
 }
 
-func resourceKubernetesCronJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
+// cronJobClient uses batch/v1 when the cluster serves it, the CronJob schema is the same in batch/v1beta1
+func cronJobClient(meta interface{}, namespace string) (*versionedResource, error) {
+	return newVersionedResource(meta, "cronjobs", "CronJob", namespace, "batch/v1", "batch/v1beta1")
+}
 
+func resourceKubernetesCronJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
 	spec, err := expandCronJobSpec(d.Get("spec").([]interface{}))
 	if err != nil {
@@ -71,9 +76,22 @@ func resourceKubernetesCronJobCreate(ctx context.Context, d *schema.ResourceData
 		Spec:       spec,
 	}
 
+	client, err := cronJobClient(meta, metadata.Namespace)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] Creating new cron job: %#v", job)
 
-	out, err := conn.BatchV1beta1().CronJobs(metadata.Namespace).Create(ctx, &job, metav1.CreateOptions{})
+	out := &v1beta1.CronJob{}
+	if useServerSideApply(meta) {
+		err = client.ApplyNew(ctx, d, meta, &job, out)
+	} else {
+		err = client.Create(ctx, &job, out)
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			err = client.Adopt(ctx, d, meta, &job, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -85,12 +103,11 @@ func resourceKubernetesCronJobCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesCronJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	namespace, _, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	namespace, _, err := idParts(d.Id())
+	client, err := cronJobClient(meta, namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -109,7 +126,12 @@ func resourceKubernetesCronJobUpdate(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[INFO] Updating cron job %s: %s", d.Id(), cronjob)
 
-	out, err := conn.BatchV1beta1().CronJobs(namespace).Update(ctx, cronjob, metav1.UpdateOptions{})
+	out := &v1beta1.CronJob{}
+	if useServerSideApply(meta) {
+		err = client.Apply(ctx, meta, cronjob, out)
+	} else {
+		err = client.Update(ctx, cronjob, out)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -127,18 +149,19 @@ func resourceKubernetesCronJobRead(ctx context.Context, d *schema.ResourceData,
 	if !exists {
 		return diag.Diagnostics{}
 	}
-	conn, err := meta.(KubeClientsets).MainClientset()
+
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := cronJobClient(meta, namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Reading cron job %s", name)
-	job, err := conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	job := &v1beta1.CronJob{}
+	err = client.Get(ctx, name, job)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return diag.FromErr(err)
@@ -167,12 +190,12 @@ func resourceKubernetesCronJobRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	jobSpec, err := flattenCronJobSpec(job.Spec, d)
+	jobSpec, err := flattenCronJobSpec(job.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -186,24 +209,23 @@ func resourceKubernetesCronJobRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceKubernetesCronJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := cronJobClient(meta, namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting cron job: %#v", name)
-	err = conn.BatchV1beta1().CronJobs(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = client.Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		err := client.Get(ctx, name, nil)
 		if err != nil {
 			if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 				return nil
@@ -225,18 +247,17 @@ func resourceKubernetesCronJobDelete(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesCronJobExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return false, err
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := cronJobClient(meta, namespace)
 	if err != nil {
 		return false, err
 	}
 
 	log.Printf("[INFO] Checking cron job %s", name)
-	_, err = conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	err = client.Get(ctx, name, nil)
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
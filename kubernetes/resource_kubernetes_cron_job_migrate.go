@@ -7,7 +7,7 @@ import (
 )
 
 func resourceKubernetesCronJobV0() *schema.Resource {
-	schemaV1 := resourceKubernetesCronJobSchemaV1()
+	schemaV1 := resourceKubernetesCronJobV1().Schema
 	schemaV0 := patchJobTemplatePodSpecWithResourcesFieldV0(schemaV1)
 	return &schema.Resource{Schema: schemaV0}
 }
@@ -15,3 +15,13 @@ func resourceKubernetesCronJobV0() *schema.Resource {
 func resourceKubernetesCronJobUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	return upgradeJobTemplatePodSpecWithResourcesFieldV0(ctx, rawState, meta)
 }
+
+func resourceKubernetesCronJobV1() *schema.Resource {
+	schemaV2 := resourceKubernetesCronJobSchemaV2()
+	schemaV1 := patchJobTemplatePodSpecWithSchedulingFieldsV1(schemaV2)
+	return &schema.Resource{Schema: schemaV1}
+}
+
+func resourceKubernetesCronJobUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return upgradeJobTemplatePodSpecWithSchedulingFieldsV1(ctx, rawState, meta)
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"k8s.io/api/batch/v1beta1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestAccKubernetesCronJob_basic(t *testing.T) {
@@ -113,11 +112,6 @@ func TestAccKubernetesCronJob_extra(t *testing.T) {
 }
 
 func testAccCheckKubernetesCronJobDestroy(s *terraform.State) error {
-	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-
-	if err != nil {
-		return err
-	}
 	ctx := context.TODO()
 
 	for _, rs := range s.RootModule().Resources {
@@ -130,7 +124,12 @@ func testAccCheckKubernetesCronJobDestroy(s *terraform.State) error {
 			return err
 		}
 
-		resp, err := conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		client, err := cronJobClient(testAccProvider.Meta(), namespace)
+		if err != nil {
+			return err
+		}
+		resp := &v1beta1.CronJob{}
+		err = client.Get(ctx, name, resp)
 		if err == nil {
 			if resp.Name == rs.Primary.ID {
 				return fmt.Errorf("CronJob still exists: %s", rs.Primary.ID)
@@ -148,24 +147,18 @@ func testAccCheckKubernetesCronJobExists(n string, obj *v1beta1.CronJob) resourc
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-		if err != nil {
-			return err
-		}
 		ctx := context.TODO()
 
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
-
-		out, err := conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		client, err := cronJobClient(testAccProvider.Meta(), namespace)
 		if err != nil {
 			return err
 		}
 
-		*obj = *out
-		return nil
+		return client.Get(ctx, name, obj)
 	}
 }
 
@@ -70,13 +70,19 @@ func resourceKubernetesCSIDriverCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	CSIDriver := storage.CSIDriver{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
-		Spec:       expandCSIDriverSpec(d.Get("spec").([]interface{})),
-	}
+	CSIDriver := expandCSIDriver(d)
 
 	log.Printf("[INFO] Creating new CSIDriver: %#v", CSIDriver)
-	out, err := conn.StorageV1beta1().CSIDrivers().Create(ctx, &CSIDriver, metav1.CreateOptions{})
+	out := CSIDriver
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.StorageV1beta1().CSIDrivers().Create(ctx, CSIDriver, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = CSIDriver
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -86,6 +92,13 @@ func resourceKubernetesCSIDriverCreate(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesCSIDriverRead(ctx, d, meta)
 }
 
+func expandCSIDriver(d *schema.ResourceData) *storage.CSIDriver {
+	return &storage.CSIDriver{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandCSIDriverSpec(d.Get("spec").([]interface{})),
+	}
+}
+
 func resourceKubernetesCSIDriverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesCSIDriverExists(ctx, d, meta)
 	if err != nil {
@@ -107,7 +120,7 @@ func resourceKubernetesCSIDriverRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received CSIDriver: %#v", CSIDriver)
-	err = d.Set("metadata", flattenMetadata(CSIDriver.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(CSIDriver.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -126,13 +139,22 @@ func resourceKubernetesCSIDriverRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesCSIDriverUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandCSIDriver(d), resourceKubernetesCSIDriverRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.StorageV1beta1().CSIDrivers().Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("spec") {
 		diffOps, err := patchCSIDriverSpec("spec.0.", "/spec", d)
 		if err != nil {
@@ -162,7 +184,7 @@ func resourceKubernetesCSIDriverDelete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("[INFO] Deleting CSIDriver: %s", d.Id())
-	err = conn.StorageV1beta1().CSIDrivers().Delete(ctx, d.Id(), metav1.DeleteOptions{})
+	err = conn.StorageV1beta1().CSIDrivers().Delete(ctx, d.Id(), expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -34,18 +34,23 @@ func resourceKubernetesDaemonSet() *schema.Resource {
 				Type:    resourceKubernetesDaemonSetV0().CoreConfigSchema().ImpliedType(),
 				Upgrade: resourceKubernetesDaemonSetUpgradeV0,
 			},
+			{
+				Version: 1,
+				Type:    resourceKubernetesDaemonSetV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKubernetesDaemonSetUpgradeV1,
+			},
 		},
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
-		Schema: resourceKubernetesDaemonSetSchemaV1(),
+		Schema: resourceKubernetesDaemonSetSchemaV2(),
 	}
 }
 
-func resourceKubernetesDaemonSetSchemaV1() map[string]*schema.Schema {
+func resourceKubernetesDaemonSetSchemaV2() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"metadata": namespacedMetadataSchema("daemonset", true),
 		"spec": {
@@ -140,29 +145,34 @@ func resourceKubernetesDaemonSetCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+	daemonset, err := expandDaemonSet(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	daemonset := appsv1.DaemonSet{
-		ObjectMeta: metadata,
-		Spec:       spec,
-	}
-
 	log.Printf("[INFO] Creating new daemonset: %#v", daemonset)
 
-	out, err := conn.AppsV1().DaemonSets(metadata.Namespace).Create(ctx, &daemonset, metav1.CreateOptions{})
+	out := daemonset
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.AppsV1().DaemonSets(daemonset.Namespace).Create(ctx, daemonset, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = daemonset
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create daemonset: %s", err)
 	}
 
 	if d.Get("wait_for_rollout").(bool) {
 		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			waitForDaemonSetReplicasFunc(ctx, conn, metadata.Namespace, metadata.Name))
+			waitForDaemonSetReplicasFunc(ctx, conn, daemonset.Namespace, daemonset.Name))
 		if err != nil {
-			return diag.FromErr(err)
+			dctx, cancel := diagnosticsContext(ctx)
+			defer cancel()
+			return diag.Errorf("%s%s", err, daemonSetRolloutDiagnostics(dctx, conn, daemonset.Namespace, daemonset.Name))
 		}
 	}
 
@@ -184,28 +194,45 @@ func resourceKubernetesDaemonSetUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-
-	if d.HasChange("spec") {
-		spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+	var out *appsv1.DaemonSet
+	if useServerSideApply(meta) {
+		out, err = expandDaemonSet(d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-
-		ops = append(ops, &ReplaceOperation{
-			Path:  "/spec",
-			Value: spec,
+		err = applyObject(ctx, meta, out)
+		if err != nil {
+			return diag.Errorf("Failed to update daemonset: %s", err)
+		}
+	} else {
+		ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+			return conn.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
 		})
-	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return diag.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating daemonset: %q", name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	out, err := conn.AppsV1().DaemonSets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to update daemonset: %s", err)
+		if d.HasChange("spec") {
+			spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/spec",
+				Value: spec,
+			})
+		}
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating daemonset: %q", name)
+
+		out, err = conn.AppsV1().DaemonSets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update daemonset: %s", err)
+		}
 	}
 	log.Printf("[INFO] Submitted updated daemonset: %#v", out)
 
@@ -213,13 +240,26 @@ func resourceKubernetesDaemonSetUpdate(ctx context.Context, d *schema.ResourceDa
 		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
 			waitForDaemonSetReplicasFunc(ctx, conn, namespace, name))
 		if err != nil {
-			return diag.FromErr(err)
+			dctx, cancel := diagnosticsContext(ctx)
+			defer cancel()
+			return diag.Errorf("%s%s", err, daemonSetRolloutDiagnostics(dctx, conn, namespace, name))
 		}
 	}
 
 	return resourceKubernetesDaemonSetRead(ctx, d, meta)
 }
 
+func expandDaemonSet(d *schema.ResourceData) (*appsv1.DaemonSet, error) {
+	spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.DaemonSet{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       spec,
+	}, nil
+}
+
 func resourceKubernetesDaemonSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesDaemonSetExists(ctx, d, meta)
 	if err != nil {
@@ -250,12 +290,12 @@ func resourceKubernetesDaemonSetRead(ctx context.Context, d *schema.ResourceData
 	}
 	log.Printf("[INFO] Received daemonset: %#v", daemonset)
 
-	err = d.Set("metadata", flattenMetadata(daemonset.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(daemonset.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	spec, err := flattenDaemonSetSpec(daemonset.Spec, d)
+	spec, err := flattenDaemonSetSpec(daemonset.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -281,7 +321,7 @@ func resourceKubernetesDaemonSetDelete(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[INFO] Deleting daemonset: %#v", name)
 
-	err = conn.AppsV1().DaemonSets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.AppsV1().DaemonSets(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
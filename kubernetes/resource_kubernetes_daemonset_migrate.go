@@ -7,7 +7,7 @@ import (
 )
 
 func resourceKubernetesDaemonSetV0() *schema.Resource {
-	schemaV1 := resourceKubernetesDaemonSetSchemaV1()
+	schemaV1 := resourceKubernetesDaemonSetV1().Schema
 	schemaV0 := patchTemplatePodSpecWithResourcesFieldV0(schemaV1)
 	return &schema.Resource{Schema: schemaV0}
 }
@@ -15,3 +15,13 @@ func resourceKubernetesDaemonSetV0() *schema.Resource {
 func resourceKubernetesDaemonSetUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	return upgradeTemplatePodSpecWithResourcesFieldV0(ctx, rawState, meta)
 }
+
+func resourceKubernetesDaemonSetV1() *schema.Resource {
+	schemaV2 := resourceKubernetesDaemonSetSchemaV2()
+	schemaV1 := patchTemplatePodSpecWithSchedulingFieldsV1(schemaV2)
+	return &schema.Resource{Schema: schemaV1}
+}
+
+func resourceKubernetesDaemonSetUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return upgradeTemplatePodSpecWithSchedulingFieldsV1(ctx, rawState, meta)
+}
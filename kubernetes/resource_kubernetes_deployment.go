@@ -17,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	deploymentutil "k8s.io/kubectl/pkg/util/deployment"
 )
 
 const (
@@ -44,13 +45,18 @@ func resourceKubernetesDeployment() *schema.Resource {
 				Type:    resourceKubernetesDeploymentV0().CoreConfigSchema().ImpliedType(),
 				Upgrade: resourceKubernetesDeploymentUpgradeV0,
 			},
+			{
+				Version: 1,
+				Type:    resourceKubernetesDeploymentV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKubernetesDeploymentUpgradeV1,
+			},
 		},
-		SchemaVersion: 1,
-		Schema:        resourceKubernetesDeploymentSchemaV1(),
+		SchemaVersion: 2,
+		Schema:        resourceKubernetesDeploymentSchemaV2(),
 	}
 }
 
-func resourceKubernetesDeploymentSchemaV1() map[string]*schema.Schema {
+func resourceKubernetesDeploymentSchemaV2() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"metadata": namespacedMetadataSchema("deployment", true),
 		"spec": {
@@ -210,6 +216,12 @@ func resourceKubernetesDeploymentSchemaV1() map[string]*schema.Schema {
 			Default:     true,
 			Optional:    true,
 		},
+		"rollback_on_failure": {
+			Type:        schema.TypeBool,
+			Description: "Restore the pod template of the previous ReplicaSet when waiting for the rollout of a template change fails. Defaults to false.",
+			Default:     false,
+			Optional:    true,
+		},
 	}
 }
 
@@ -219,19 +231,22 @@ func resourceKubernetesDeploymentCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	deployment, err := expandDeployment(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	deployment := appsv1.Deployment{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
-
 	log.Printf("[INFO] Creating new deployment: %#v", deployment)
-	out, err := conn.AppsV1().Deployments(metadata.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
+	out := deployment
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.AppsV1().Deployments(deployment.Namespace).Create(ctx, deployment, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = deployment
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create deployment: %s", err)
 	}
@@ -245,7 +260,7 @@ func resourceKubernetesDeploymentCreate(ctx context.Context, d *schema.ResourceD
 		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
 			waitForDeploymentReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
 		if err != nil {
-			return diag.FromErr(err)
+			return resourceKubernetesDeploymentRolloutFailed(ctx, d, meta, out.GetNamespace(), out.GetName(), err)
 		}
 	}
 
@@ -265,27 +280,44 @@ func resourceKubernetesDeploymentUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-
-	if d.HasChange("spec") {
-		spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	var out *appsv1.Deployment
+	if useServerSideApply(meta) {
+		out, err = expandDeployment(d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-
-		ops = append(ops, &ReplaceOperation{
-			Path:  "/spec",
-			Value: spec,
+		err = applyObject(ctx, meta, out)
+		if err != nil {
+			return diag.Errorf("Failed to update deployment: %s", err)
+		}
+	} else {
+		ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+			return conn.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
 		})
-	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return diag.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating deployment %q: %v", name, string(data))
-	out, err := conn.AppsV1().Deployments(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to update deployment: %s", err)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if d.HasChange("spec") {
+			spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/spec",
+				Value: spec,
+			})
+		}
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating deployment %q: %v", name, string(data))
+		out, err = conn.AppsV1().Deployments(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update deployment: %s", err)
+		}
 	}
 	log.Printf("[INFO] Submitted updated deployment: %#v", out)
 
@@ -294,13 +326,24 @@ func resourceKubernetesDeploymentUpdate(ctx context.Context, d *schema.ResourceD
 		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
 			waitForDeploymentReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
 		if err != nil {
-			return diag.FromErr(err)
+			return resourceKubernetesDeploymentRolloutFailed(ctx, d, meta, out.GetNamespace(), out.GetName(), err)
 		}
 	}
 
 	return resourceKubernetesDeploymentRead(ctx, d, meta)
 }
 
+func expandDeployment(d *schema.ResourceData) (*appsv1.Deployment, error) {
+	spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesDeploymentExists(ctx, d, meta)
 	if err != nil {
@@ -327,12 +370,12 @@ func resourceKubernetesDeploymentRead(ctx context.Context, d *schema.ResourceDat
 	}
 	log.Printf("[INFO] Received deployment: %#v", deployment)
 
-	err = d.Set("metadata", flattenMetadata(deployment.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(deployment.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	spec, err := flattenDeploymentSpec(deployment.Spec, d)
+	spec, err := flattenDeploymentSpec(deployment.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -358,7 +401,7 @@ func resourceKubernetesDeploymentDelete(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[INFO] Deleting deployment: %#v", name)
 
-	err = conn.AppsV1().Deployments(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.AppsV1().Deployments(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -407,6 +450,94 @@ func resourceKubernetesDeploymentExists(ctx context.Context, d *schema.ResourceD
 	return true, err
 }
 
+// resourceKubernetesDeploymentRolloutFailed reports a failed rollout and, if
+// enabled, rolls the deployment back so the state matches the cluster again.
+func resourceKubernetesDeploymentRolloutFailed(ctx context.Context, d *schema.ResourceData, meta interface{}, namespace, name string, rolloutErr error) diag.Diagnostics {
+	ctx, cancel := diagnosticsContext(ctx)
+	defer cancel()
+
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	msg := fmt.Sprintf("%s%s", rolloutErr, deploymentRolloutDiagnostics(ctx, conn, namespace, name))
+	if d.IsNewResource() || !d.Get("rollback_on_failure").(bool) || !d.HasChange("spec.0.template") {
+		return diag.Errorf("%s", msg)
+	}
+
+	rs, err := rollbackDeployment(ctx, conn, namespace, name)
+	if err != nil {
+		return diag.Errorf("%s\n\nFailed to roll back deployment: %s", msg, err)
+	}
+	log.Printf("[INFO] Rolled back deployment %s/%s to ReplicaSet %s", namespace, name, rs.Name)
+
+	diags := resourceKubernetesDeploymentRead(ctx, d, meta)
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Deployment %s/%s failed to roll out and was rolled back", namespace, name),
+		Detail: fmt.Sprintf("%s\n\nRestored the pod template of ReplicaSet %s (revision %s).",
+			msg, rs.Name, rs.Annotations[deploymentutil.RevisionAnnotation]),
+	})
+}
+
+// rollbackDeployment restores the pod template of the newest ReplicaSet
+// preceding the current one, like "kubectl rollout undo" does.
+func rollbackDeployment(ctx context.Context, conn *kubernetes.Clientset, namespace, name string) (*appsv1.ReplicaSet, error) {
+	dply, err := conn.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if dply.Spec.Paused {
+		return nil, fmt.Errorf("a paused deployment cannot be rolled back")
+	}
+	_, oldRSs, _, err := deploymentutil.GetAllReplicaSets(dply, conn.AppsV1())
+	if err != nil {
+		return nil, err
+	}
+	previous := previousReplicaSet(oldRSs)
+	if previous == nil {
+		return nil, fmt.Errorf("no previous ReplicaSet found, check revision_history_limit")
+	}
+
+	template := previous.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	ops := PatchOperations{
+		&ReplaceOperation{
+			Path:  "/spec/template",
+			Value: template,
+		},
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] Rolling back deployment %q: %v", name, string(data))
+	_, err = conn.AppsV1().Deployments(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
+// previousReplicaSet returns the ReplicaSet with the highest revision
+// among the ones which do not match the current pod template.
+func previousReplicaSet(oldRSs []*appsv1.ReplicaSet) *appsv1.ReplicaSet {
+	var previous *appsv1.ReplicaSet
+	var previousRevision int64
+	for _, rs := range oldRSs {
+		v, err := deploymentutil.Revision(rs)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping ReplicaSet %s: %s", rs.Name, err)
+			continue
+		}
+		if previous == nil || v > previousRevision {
+			previous, previousRevision = rs, v
+		}
+	}
+	return previous
+}
+
 // GetDeploymentConditionInternal returns the condition with the provided type.
 // Borrowed from: https://github.com/kubernetes/kubernetes/blob/master/pkg/controller/deployment/util/deployment_util.go#L135
 func GetDeploymentCondition(status appsv1.DeploymentStatus, condType appsv1.DeploymentConditionType) *appsv1.DeploymentCondition {
@@ -7,7 +7,7 @@ import (
 )
 
 func resourceKubernetesDeploymentV0() *schema.Resource {
-	schemaV1 := resourceKubernetesDeploymentSchemaV1()
+	schemaV1 := resourceKubernetesDeploymentV1().Schema
 	schemaV0 := patchTemplatePodSpecWithResourcesFieldV0(schemaV1)
 	return &schema.Resource{Schema: schemaV0}
 }
@@ -15,3 +15,13 @@ func resourceKubernetesDeploymentV0() *schema.Resource {
 func resourceKubernetesDeploymentUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	return upgradeTemplatePodSpecWithResourcesFieldV0(ctx, rawState, meta)
 }
+
+func resourceKubernetesDeploymentV1() *schema.Resource {
+	schemaV2 := resourceKubernetesDeploymentSchemaV2()
+	schemaV1 := patchTemplatePodSpecWithSchedulingFieldsV1(schemaV2)
+	return &schema.Resource{Schema: schemaV1}
+}
+
+func resourceKubernetesDeploymentUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return upgradeTemplatePodSpecWithSchedulingFieldsV1(ctx, rawState, meta)
+}
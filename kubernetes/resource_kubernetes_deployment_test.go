@@ -210,7 +210,7 @@ func TestAccKubernetesDeployment_generatedName(t *testing.T) {
 				ResourceName:            "kubernetes_deployment.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_rollout"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_rollout", "rollback_on_failure"},
 			},
 		},
 	})
@@ -789,6 +789,35 @@ func TestAccKubernetesDeployment_with_share_process_namespace(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDeployment_with_topology_spread_constraint(t *testing.T) {
+	var conf appsv1.Deployment
+
+	deploymentName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := nginxImageVersion
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfigWithTopologySpreadConstraint(deploymentName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.topology_spread_constraint.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.topology_spread_constraint.0.max_skew", "1"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.topology_spread_constraint.0.topology_key", "kubernetes.io/hostname"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.topology_spread_constraint.0.when_unsatisfiable", "ScheduleAnyway"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.topology_spread_constraint.0.label_selector.0.match_labels.Test", "TfAcceptanceTest"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.scheduler_name", "default-scheduler"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.preemption_policy", "PreemptLowerPriority"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.set_hostname_as_fqdn", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesDeployment_no_rollout_wait(t *testing.T) {
 	deploymentName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	imageName := nginxImageVersion
@@ -809,6 +838,59 @@ func TestAccKubernetesDeployment_no_rollout_wait(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDeployment_rollbackOnFailure(t *testing.T) {
+	var conf appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfigRollbackOnFailure(name, nginxImageVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "rollback_on_failure", "true"),
+				),
+			},
+			{
+				Config:      testAccKubernetesDeploymentConfigRollbackOnFailure(name, "nginx:this-tag-does-not-exist"),
+				ExpectError: regexp.MustCompile("failed to roll out and was rolled back"),
+			},
+			{
+				// The state holds the restored template, so the original configuration has no changes
+				Config:   testAccKubernetesDeploymentConfigRollbackOnFailure(name, nginxImageVersion),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestPreviousReplicaSet(t *testing.T) {
+	replicaSet := func(name, revision string) *appsv1.ReplicaSet {
+		rs := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if revision != "" {
+			rs.Annotations = map[string]string{"deployment.kubernetes.io/revision": revision}
+		}
+		return rs
+	}
+
+	if rs := previousReplicaSet(nil); rs != nil {
+		t.Fatalf("Expected no ReplicaSet, given: %s", rs.Name)
+	}
+	rs := previousReplicaSet([]*appsv1.ReplicaSet{
+		replicaSet("web-1", "2"),
+		replicaSet("web-2", "10"),
+		replicaSet("web-3", "invalid"),
+		replicaSet("web-4", ""),
+		replicaSet("web-5", "9"),
+	})
+	if rs == nil || rs.Name != "web-2" {
+		t.Fatalf("Expected ReplicaSet %q, given: %#v", "web-2", rs)
+	}
+}
+
 func TestAccKubernetesDeployment_with_deployment_strategy_rollingupdate_max_surge_30perc_max_unavailable_40perc(t *testing.T) {
 	var conf appsv1.Deployment
 
@@ -2310,6 +2392,58 @@ func testAccKubernetesDeploymentConfigWithShareProcessNamespace(deploymentName,
 `, deploymentName, imageName, imageName)
 }
 
+func testAccKubernetesDeploymentConfigWithTopologySpreadConstraint(deploymentName, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+
+    labels = {
+      Test = "TfAcceptanceTest"
+    }
+  }
+
+  spec {
+    selector {
+      match_labels = {
+        Test = "TfAcceptanceTest"
+      }
+    }
+
+    template {
+      metadata {
+        labels = {
+          Test = "TfAcceptanceTest"
+        }
+      }
+
+      spec {
+        scheduler_name       = "default-scheduler"
+        preemption_policy    = "PreemptLowerPriority"
+        set_hostname_as_fqdn = true
+
+        topology_spread_constraint {
+          max_skew           = 1
+          topology_key       = "kubernetes.io/hostname"
+          when_unsatisfiable = "ScheduleAnyway"
+
+          label_selector {
+            match_labels = {
+              Test = "TfAcceptanceTest"
+            }
+          }
+        }
+
+        container {
+          image = "%s"
+          name  = "containername"
+        }
+      }
+    }
+  }
+}
+`, deploymentName, imageName)
+}
+
 func testAccKubernetesDeploymentConfigWithDeploymentStrategyRollingUpdate(deploymentName, maxSurge, maxUnavailable, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_deployment" "test" {
   metadata {
@@ -2495,6 +2629,38 @@ func testAccKubernetesDeploymentConfigWithWaitForRolloutFalse(deploymentName, im
 `, deploymentName, nginxImageVersion)
 }
 
+func testAccKubernetesDeploymentConfigRollbackOnFailure(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    replicas                  = 1
+    progress_deadline_seconds = 30
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "%s"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+  rollback_on_failure = true
+}
+`, name, imageName)
+}
+
 func testAccKubernetesDeploymentConfigLocal(provider, name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_deployment" "test" {
   provider = %s
@@ -41,13 +41,18 @@ func resourceKubernetesEndpointsCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	ep := api.Endpoints{
-		ObjectMeta: metadata,
-		Subsets:    expandEndpointsSubsets(d.Get("subset").(*schema.Set)),
-	}
+	ep := expandEndpoints(d)
 	log.Printf("[INFO] Creating new endpoints: %#v", ep)
-	out, err := conn.CoreV1().Endpoints(metadata.Namespace).Create(ctx, &ep, metav1.CreateOptions{})
+	out := ep
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().Endpoints(ep.Namespace).Create(ctx, ep, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = ep
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create endpoints because: %s", err)
 	}
@@ -57,6 +62,13 @@ func resourceKubernetesEndpointsCreate(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesEndpointsRead(ctx, d, meta)
 }
 
+func expandEndpoints(d *schema.ResourceData) *api.Endpoints {
+	return &api.Endpoints{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Subsets:    expandEndpointsSubsets(d.Get("subset").(*schema.Set)),
+	}
+}
+
 func resourceKubernetesEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesEndpointsExists(ctx, d, meta)
 	if err != nil {
@@ -82,7 +94,7 @@ func resourceKubernetesEndpointsRead(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("Failed to read endpoint because: %s", err)
 	}
 	log.Printf("[INFO] Received endpoints: %#v", ep)
-	err = d.Set("metadata", flattenMetadata(ep.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(ep.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.Errorf("Failed to read endpoints because: %s", err)
 	}
@@ -98,6 +110,10 @@ func resourceKubernetesEndpointsRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesEndpointsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandEndpoints(d), resourceKubernetesEndpointsRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -108,7 +124,12 @@ func resourceKubernetesEndpointsUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("Failed to update endpoints because: %s", err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.CoreV1().Endpoints(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("subset") {
 		subsets := expandEndpointsSubsets(d.Get("subset").(*schema.Set))
 		ops = append(ops, &ReplaceOperation{
@@ -142,7 +163,7 @@ func resourceKubernetesEndpointsDelete(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("Failed to delete endpoints because: %s", err)
 	}
 	log.Printf("[INFO] Deleting endpoints: %#v", name)
-	err = conn.CoreV1().Endpoints(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().Endpoints(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.Errorf("Failed to delete endpoints because: %s", err)
 	}
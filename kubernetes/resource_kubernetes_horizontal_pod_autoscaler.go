@@ -30,6 +30,13 @@ func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"behavior": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Behavior configures the scaling behavior of the target in both Up and Down directions (`scale_up` and `scale_down` fields respectively).",
+							Elem:        horizontalPodAutoscalerBehaviorFields(),
+						},
 						"max_replicas": {
 							Type:        schema.TypeInt,
 							Description: "Upper limit for the number of pods that can be set by the autoscaler.",
@@ -81,6 +88,12 @@ func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
 					},
 				},
 			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Current information about the autoscaler.",
+				Computed:    true,
+				Elem:        horizontalPodAutoscalerStatusFields(),
+			},
 		},
 	}
 }
@@ -95,18 +108,21 @@ func resourceKubernetesHorizontalPodAutoscalerCreate(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandHorizontalPodAutoscalerSpec(d.Get("spec").([]interface{}))
+	svc, err := expandHorizontalPodAutoscaler(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	svc := api.HorizontalPodAutoscaler{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new horizontal pod autoscaler: %#v", svc)
-	out, err := conn.AutoscalingV1().HorizontalPodAutoscalers(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
+	out := svc
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.AutoscalingV1().HorizontalPodAutoscalers(svc.Namespace).Create(ctx, svc, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = svc
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -117,6 +133,17 @@ func resourceKubernetesHorizontalPodAutoscalerCreate(ctx context.Context, d *sch
 	return resourceKubernetesHorizontalPodAutoscalerRead(ctx, d, meta)
 }
 
+func expandHorizontalPodAutoscaler(d *schema.ResourceData) (*api.HorizontalPodAutoscaler, error) {
+	spec, err := expandHorizontalPodAutoscalerSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.HorizontalPodAutoscaler{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesHorizontalPodAutoscalerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesHorizontalPodAutoscalerExists(ctx, d, meta)
 	if err != nil {
@@ -146,12 +173,14 @@ func resourceKubernetesHorizontalPodAutoscalerRead(ctx context.Context, d *schem
 	}
 
 	// NOTE: this is needed for import
-	if _, exists := hpa.ObjectMeta.GetAnnotations()["autoscaling.alpha.kubernetes.io/metrics"]; exists {
-		return resourceKubernetesHorizontalPodAutoscalerV2Read(ctx, d, meta)
+	for _, a := range []string{"autoscaling.alpha.kubernetes.io/metrics", "autoscaling.alpha.kubernetes.io/behavior"} {
+		if _, exists := hpa.ObjectMeta.GetAnnotations()[a]; exists {
+			return resourceKubernetesHorizontalPodAutoscalerV2Read(ctx, d, meta)
+		}
 	}
 
 	log.Printf("[INFO] Received horizontal pod autoscaler: %#v", hpa)
-	err = d.Set("metadata", flattenMetadata(hpa.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(hpa.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -163,6 +192,11 @@ func resourceKubernetesHorizontalPodAutoscalerRead(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
+	err = d.Set("status", flattenHorizontalPodAutoscalerStatus(hpa.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -171,6 +205,14 @@ func resourceKubernetesHorizontalPodAutoscalerUpdate(ctx context.Context, d *sch
 		return resourceKubernetesHorizontalPodAutoscalerV2Update(ctx, d, meta)
 	}
 
+	if useServerSideApply(meta) {
+		svc, err := expandHorizontalPodAutoscaler(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return applyResourceUpdate(ctx, d, meta, svc, resourceKubernetesHorizontalPodAutoscalerRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -181,7 +223,12 @@ func resourceKubernetesHorizontalPodAutoscalerUpdate(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("spec") {
 		diffOps := patchHorizontalPodAutoscalerSpec("spec.0.", "/spec", d)
 		ops = append(ops, diffOps...)
@@ -216,7 +263,7 @@ func resourceKubernetesHorizontalPodAutoscalerDelete(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Deleting horizontal pod autoscaler: %#v", name)
-	err = conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -258,5 +305,10 @@ func useV2(d *schema.ResourceData) bool {
 		log.Printf("[INFO] Using autoscaling/v2beta2 because this resource has a metric field")
 		return true
 	}
+	// autoscaling/v1 keeps the behavior in an annotation, which would be left in place when removing it
+	if len(d.Get("spec.0.behavior").([]interface{})) > 0 || d.HasChange("spec.0.behavior") {
+		log.Printf("[INFO] Using autoscaling/v2beta2 because this resource has a behavior field")
+		return true
+	}
 	return false
 }
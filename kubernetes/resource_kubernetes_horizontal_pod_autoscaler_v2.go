@@ -18,18 +18,21 @@ func resourceKubernetesHorizontalPodAutoscalerV2Create(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandHorizontalPodAutoscalerV2Spec(d.Get("spec").([]interface{}))
+	hpa, err := expandHorizontalPodAutoscalerV2(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	hpa := autoscalingv2beta2.HorizontalPodAutoscaler{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new horizontal pod autoscaler: %#v", hpa)
-	out, err := conn.AutoscalingV2beta2().HorizontalPodAutoscalers(metadata.Namespace).Create(ctx, &hpa, metav1.CreateOptions{})
+	out := hpa
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.AutoscalingV2beta2().HorizontalPodAutoscalers(hpa.Namespace).Create(ctx, hpa, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = hpa
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -40,6 +43,17 @@ func resourceKubernetesHorizontalPodAutoscalerV2Create(ctx context.Context, d *s
 	return resourceKubernetesHorizontalPodAutoscalerV2Read(ctx, d, meta)
 }
 
+func expandHorizontalPodAutoscalerV2(d *schema.ResourceData) (*autoscalingv2beta2.HorizontalPodAutoscaler, error) {
+	spec, err := expandHorizontalPodAutoscalerV2Spec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &autoscalingv2beta2.HorizontalPodAutoscaler{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesHorizontalPodAutoscalerV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesHorizontalPodAutoscalerV2Exists(ctx, d, meta)
 	if err != nil {
@@ -64,7 +78,7 @@ func resourceKubernetesHorizontalPodAutoscalerV2Read(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received horizontal pod autoscaler: %#v", hpa)
-	err = d.Set("metadata", flattenMetadata(hpa.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(hpa.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -76,10 +90,23 @@ func resourceKubernetesHorizontalPodAutoscalerV2Read(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
+	err = d.Set("status", flattenHorizontalPodAutoscalerV2Status(hpa.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
 func resourceKubernetesHorizontalPodAutoscalerV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		hpa, err := expandHorizontalPodAutoscalerV2(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return applyResourceUpdate(ctx, d, meta, hpa, resourceKubernetesHorizontalPodAutoscalerV2Read)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -90,7 +117,12 @@ func resourceKubernetesHorizontalPodAutoscalerV2Update(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.AutoscalingV2beta2().HorizontalPodAutoscalers(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("spec") {
 		diffOps := patchHorizontalPodAutoscalerV2Spec("spec.0.", "/spec", d)
 		ops = append(ops, diffOps...)
@@ -121,7 +153,7 @@ func resourceKubernetesHorizontalPodAutoscalerV2Delete(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Deleting horizontal pod autoscaler: %#v", name)
-	err = conn.AutoscalingV2beta2().HorizontalPodAutoscalers(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.AutoscalingV2beta2().HorizontalPodAutoscalers(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
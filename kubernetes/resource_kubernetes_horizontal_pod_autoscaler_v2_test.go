@@ -93,6 +93,51 @@ func TestAccKubernetesHorizontalPodAutoscalerV2_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesHorizontalPodAutoscalerV2_behavior(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_horizontal_pod_autoscaler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesHorizontalPodAutoscalerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesHorizontalPodAutoscalerV2Config_behavior(name, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesHorizontalPodAutoscalerV2Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.stabilization_window_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.select_policy", "Min"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.policy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.policy.0.type", "Pods"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.policy.0.value", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.policy.0.period_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.policy.1.type", "Percent"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_up.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_up.0.stabilization_window_seconds", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_up.0.select_policy", "Max"),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
+			},
+			{
+				Config: testAccKubernetesHorizontalPodAutoscalerV2Config_behavior(name, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesHorizontalPodAutoscalerV2Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.stabilization_window_seconds", "600"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesHorizontalPodAutoscalerV2Exists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -250,3 +295,40 @@ func testAccKubernetesHorizontalPodAutoscalerV2Config_modified(name string) stri
 }
 `, name)
 }
+
+func testAccKubernetesHorizontalPodAutoscalerV2Config_behavior(name string, scaleDownWindow int) string {
+	return fmt.Sprintf(`resource "kubernetes_horizontal_pod_autoscaler" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    max_replicas = 10
+
+    scale_target_ref {
+      kind = "Deployment"
+      name = "TerraformAccTest"
+    }
+
+    behavior {
+      scale_down {
+        stabilization_window_seconds = %d
+        select_policy                = "Min"
+
+        policy {
+          type           = "Pods"
+          value          = 1
+          period_seconds = 60
+        }
+
+        policy {
+          type           = "Percent"
+          value          = 10
+          period_seconds = 60
+        }
+      }
+    }
+  }
+}
+`, name, scaleDownWindow)
+}
@@ -6,11 +6,12 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	networking "k8s.io/api/networking/v1beta1"
+	networking "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"k8s.io/api/extensions/v1beta1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -39,7 +40,7 @@ func resourceKubernetesIngress() *schema.Resource {
 
 func resourceKubernetesIngressSchemaV1() map[string]*schema.Schema {
 	docHTTPIngressPath := networking.HTTPIngressPath{}.SwaggerDoc()
-	docHTTPIngressRuleValue := networking.HTTPIngressPath{}.SwaggerDoc()
+	docHTTPIngressRuleValue := networking.HTTPIngressRuleValue{}.SwaggerDoc()
 	docIngress := networking.Ingress{}.SwaggerDoc()
 	docIngressTLS := networking.IngressTLS{}.SwaggerDoc()
 	docIngressRule := networking.IngressRule{}.SwaggerDoc()
@@ -89,6 +90,13 @@ func resourceKubernetesIngressSchemaV1() map[string]*schema.Schema {
 															Description: docHTTPIngressPath["path"],
 															Optional:    true,
 														},
+														"path_type": {
+															Type:         schema.TypeString,
+															Description:  docHTTPIngressPath["pathType"],
+															Optional:     true,
+															Computed:     true,
+															ValidateFunc: validation.StringInSlice([]string{"Exact", "Prefix", "ImplementationSpecific"}, false),
+														},
 														"backend": backendSpecFields(ruleBackedDescription),
 													},
 												},
@@ -162,19 +170,62 @@ func resourceKubernetesIngressSchemaV1() map[string]*schema.Schema {
 	}
 }
 
+// ingressClient uses networking.k8s.io/v1 when the cluster serves it
+func ingressClient(meta interface{}, namespace string) (*versionedResource, error) {
+	return newVersionedResource(meta, "ingresses", "Ingress", namespace,
+		"networking.k8s.io/v1", "networking.k8s.io/v1beta1", "extensions/v1beta1")
+}
+
+// doIngress calls fn with the ingress converted to the negotiated version,
+// and converts the ingress fn decoded into out back to networking.k8s.io/v1.
+func doIngress(client *versionedResource, in *networking.Ingress, fn func(in, out interface{}) error) (*networking.Ingress, error) {
+	if client.GroupVersion() == "networking.k8s.io/v1" {
+		out := &networking.Ingress{}
+		if err := fn(in, out); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
+	var obj interface{}
+	if in != nil {
+		obj = ingressToV1beta1(in)
+	}
+	out := &networkingv1beta1.Ingress{}
+	if err := fn(obj, out); err != nil {
+		return nil, err
+	}
+	return ingressFromV1beta1(out), nil
+}
+
+func getIngress(ctx context.Context, client *versionedResource, name string) (*networking.Ingress, error) {
+	return doIngress(client, nil, func(_, out interface{}) error {
+		return client.Get(ctx, name, out)
+	})
+}
+
 func resourceKubernetesIngressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	client, err := ingressClient(meta, metadata.Namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	ing := &v1beta1.Ingress{
+	ing := &networking.Ingress{
 		Spec: expandIngressSpec(d.Get("spec").([]interface{})),
 	}
 	ing.ObjectMeta = metadata
 	log.Printf("[INFO] Creating new ingress: %#v", ing)
-	out, err := conn.ExtensionsV1beta1().Ingresses(metadata.Namespace).Create(ctx, ing, metav1.CreateOptions{})
+	out, err := doIngress(client, ing, func(in, out interface{}) error {
+		if useServerSideApply(meta) {
+			return client.ApplyNew(ctx, d, meta, in, out)
+		}
+		err := client.Create(ctx, in, out)
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			return client.Adopt(ctx, d, meta, in, out)
+		}
+		return err
+	})
 	if err != nil {
 		return diag.Errorf("Failed to create Ingress '%s' because: %s", buildId(ing.ObjectMeta), err)
 	}
@@ -187,7 +238,7 @@ func resourceKubernetesIngressCreate(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[INFO] Waiting for load balancer to become ready: %#v", out)
 	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		res, err := conn.ExtensionsV1beta1().Ingresses(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+		res, err := getIngress(ctx, client, metadata.Name)
 		if err != nil {
 			// NOTE it is possible in some HA apiserver setups that are eventually consistent
 			// that we could get a 404 when doing a Get immediately after a Create
@@ -223,24 +274,24 @@ func resourceKubernetesIngressRead(ctx context.Context, d *schema.ResourceData,
 	if !exists {
 		return diag.Diagnostics{}
 	}
-	conn, err := meta.(KubeClientsets).MainClientset()
+
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := ingressClient(meta, namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Reading ingress %s", name)
-	ing, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	ing, err := getIngress(ctx, client, name)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
-		return diag.Errorf("Failed to read Ingress '%s' because: %s", buildId(ing.ObjectMeta), err)
+		return diag.Errorf("Failed to read Ingress '%s' because: %s", d.Id(), err)
 	}
 	log.Printf("[INFO] Received ingress: %#v", ing)
-	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -265,12 +316,11 @@ func resourceKubernetesIngressRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceKubernetesIngressUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	namespace, _, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	namespace, _, err := idParts(d.Id())
+	client, err := ingressClient(meta, namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -282,12 +332,17 @@ func resourceKubernetesIngressUpdate(ctx context.Context, d *schema.ResourceData
 		metadata.Namespace = "default"
 	}
 
-	ingress := &v1beta1.Ingress{
+	ingress := &networking.Ingress{
 		ObjectMeta: metadata,
 		Spec:       spec,
 	}
 
-	out, err := conn.ExtensionsV1beta1().Ingresses(namespace).Update(ctx, ingress, metav1.UpdateOptions{})
+	out, err := doIngress(client, ingress, func(in, out interface{}) error {
+		if useServerSideApply(meta) {
+			return client.Apply(ctx, meta, in, out)
+		}
+		return client.Update(ctx, in, out)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to update Ingress %s because: %s", buildId(ingress.ObjectMeta), err)
 	}
@@ -297,18 +352,17 @@ func resourceKubernetesIngressUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesIngressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := ingressClient(meta, namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting ingress: %#v", name)
-	err = conn.ExtensionsV1beta1().Ingresses(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = client.Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.Errorf("Failed to delete Ingress %s because: %s", d.Id(), err)
 	}
@@ -320,18 +374,17 @@ func resourceKubernetesIngressDelete(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesIngressExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return false, err
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := ingressClient(meta, namespace)
 	if err != nil {
 		return false, err
 	}
 
 	log.Printf("[INFO] Checking ingress %s", name)
-	_, err = conn.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	err = client.Get(ctx, name, nil)
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
@@ -0,0 +1,229 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+const ingressClassDefaultAnnotation = "ingressclass.kubernetes.io/is-default-class"
+
+func resourceKubernetesIngressClass() *schema.Resource {
+	docIngressClassSpec := networking.IngressClassSpec{}.SwaggerDoc()
+
+	return &schema.Resource{
+		CreateContext: resourceKubernetesIngressClassCreate,
+		ReadContext:   resourceKubernetesIngressClassRead,
+		UpdateContext: resourceKubernetesIngressClassUpdate,
+		DeleteContext: resourceKubernetesIngressClassDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("ingress class", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: networking.IngressClass{}.SwaggerDoc()["spec"],
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"controller": {
+							Type:        schema.TypeString,
+							Description: docIngressClassSpec["controller"],
+							Required:    true,
+							ForceNew:    true,
+						},
+						"parameters": typedLocalObjectReferenceSchema(docIngressClassSpec["parameters"]),
+					},
+				},
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "Whether the ingress class is the default of the cluster, given to the ingresses which do not specify an `ingress_class_name`. It is stored in the `" + ingressClassDefaultAnnotation + "` annotation.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+// ingressClassClient uses networking.k8s.io/v1 when the cluster serves it, the IngressClass
+// schema is the same in networking.k8s.io/v1beta1
+func ingressClassClient(meta interface{}) (*versionedResource, error) {
+	return newVersionedResource(meta, "ingressclasses", "IngressClass", "",
+		"networking.k8s.io/v1", "networking.k8s.io/v1beta1")
+}
+
+func resourceKubernetesIngressClassCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := ingressClassClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ingressClass := expandIngressClass(d)
+
+	log.Printf("[INFO] Creating new ingress class: %#v", ingressClass)
+	out := &networking.IngressClass{}
+	if useServerSideApply(meta) {
+		err = client.ApplyNew(ctx, d, meta, ingressClass, out)
+	} else {
+		err = client.Create(ctx, ingressClass, out)
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			err = client.Adopt(ctx, d, meta, ingressClass, out)
+		}
+	}
+	if err != nil {
+		return diag.Errorf("Failed to create ingress class: %s", err)
+	}
+	log.Printf("[INFO] Submitted new ingress class: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesIngressClassRead(ctx, d, meta)
+}
+
+func expandIngressClass(d *schema.ResourceData) *networking.IngressClass {
+	ingressClass := &networking.IngressClass{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandIngressClassSpec(d.Get("spec").([]interface{})),
+	}
+	if d.Get("default").(bool) {
+		if ingressClass.Annotations == nil {
+			ingressClass.Annotations = map[string]string{}
+		}
+		ingressClass.Annotations[ingressClassDefaultAnnotation] = "true"
+	}
+	return ingressClass
+}
+
+func resourceKubernetesIngressClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := ingressClassClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Reading ingress class %s", name)
+	ingressClass := &networking.IngressClass{}
+	err = client.Get(ctx, name, ingressClass)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[INFO] Ingress class %s not found, removing from state", name)
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received ingress class: %#v", ingressClass)
+
+	err = d.Set("metadata", flattenMetadata(ingressClass.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("spec", flattenIngressClassSpec(ingressClass.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("default", ingressClass.Annotations[ingressClassDefaultAnnotation] == "true")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesIngressClassUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := ingressClassClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if useServerSideApply(meta) {
+		err = client.Apply(ctx, meta, expandIngressClass(d), &networking.IngressClass{})
+		if err != nil {
+			return diag.Errorf("Failed to update ingress class: %s", err)
+		}
+		return resourceKubernetesIngressClassRead(ctx, d, meta)
+	}
+
+	name := d.Id()
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		out := &networking.IngressClass{}
+		err := client.Get(ctx, name, out)
+		return out, err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("spec.0.parameters") {
+		ops = append(ops, patchIngressClassParameters(d)...)
+	}
+	if len(ops) > 0 {
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating ingress class %q: %v", name, string(data))
+		err = client.Patch(ctx, name, pkgApi.JSONPatchType, data, nil)
+		if err != nil {
+			return diag.Errorf("Failed to update ingress class: %s", err)
+		}
+	}
+
+	// The default annotation is hidden from metadata.annotations, it's asserted
+	// again whenever they change so it's never lost along with them.
+	if d.HasChange("default") || d.HasChange("metadata.0.annotations") {
+		// A merge patch also works when the ingress class has no annotations yet
+		var value interface{}
+		if d.Get("default").(bool) {
+			value = "true"
+		}
+		data, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					ingressClassDefaultAnnotation: value,
+				},
+			},
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("[INFO] Updating ingress class %q: %v", name, string(data))
+		err = client.Patch(ctx, name, pkgApi.MergePatchType, data, nil)
+		if err != nil {
+			return diag.Errorf("Failed to update ingress class: %s", err)
+		}
+	}
+	log.Printf("[INFO] Submitted updated ingress class: %s", name)
+
+	return resourceKubernetesIngressClassRead(ctx, d, meta)
+}
+
+func resourceKubernetesIngressClassDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := ingressClassClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting ingress class: %#v", name)
+	err = client.Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
+	if err != nil && !errors.IsNotFound(err) {
+		return diag.Errorf("Failed to delete ingress class: %s", err)
+	}
+	log.Printf("[INFO] Ingress class %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,303 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesIngressClass_basic(t *testing.T) {
+	var conf networking.IngressClass
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_ingress_class.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesIngressClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesIngressClassConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.controller", "example.com/ingress-controller"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesIngressClassConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.controller", "example.com/ingress-controller"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.0.api_group", "k8s.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.0.kind", "IngressParameters"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.0.name", "external-lb"),
+					resource.TestCheckResourceAttr(resourceName, "default", "true"),
+					testAccCheckIngressClassDefaultAnnotation(&conf, "true"),
+				),
+			},
+			{
+				Config: testAccKubernetesIngressClassConfig_annotated(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.TestAnnotationOne", "one"),
+					resource.TestCheckResourceAttr(resourceName, "default", "true"),
+					testAccCheckIngressClassDefaultAnnotation(&conf, "true"),
+				),
+			},
+			{
+				Config: testAccKubernetesIngressClassConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default", "true"),
+					testAccCheckIngressClassDefaultAnnotation(&conf, "true"),
+				),
+			},
+			{
+				Config: testAccKubernetesIngressClassConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default", "false"),
+					testAccCheckIngressClassDefaultAnnotation(&conf, ""),
+				),
+			},
+		},
+	})
+}
+
+func TestPatchMetadata_ingressClassDefault(t *testing.T) {
+	r := resourceKubernetesIngressClass()
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"id":                       "test",
+			"metadata.#":               "1",
+			"metadata.0.name":          "test",
+			"metadata.0.annotations.%": "0",
+			"spec.#":                   "1",
+			"spec.0.controller":        "example.com/ingress-controller",
+			"default":                  "true",
+		},
+	}
+	live := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test",
+			Annotations: map[string]string{ingressClassDefaultAnnotation: "true"},
+		},
+	}
+
+	// Adding the first annotation keeps the default annotation
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{
+				"name":        "test",
+				"annotations": map[string]interface{}{"TestAnnotationOne": "one"},
+			},
+		},
+		"spec":    []interface{}{map[string]interface{}{"controller": "example.com/ingress-controller"}},
+		"default": true,
+	})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, nil, func() (metav1.Object, error) {
+		return live, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedOps := PatchOperations{
+		&AddOperation{Path: "/metadata/annotations/TestAnnotationOne", Value: "one"},
+	}
+	if !ops.Equal(expectedOps) {
+		t.Fatalf("Unexpected operations.\nExpected: %#v\nGiven:    %#v", expectedOps, ops)
+	}
+
+	// Removing the last annotation only removes its key
+	state.Attributes["metadata.0.annotations.%"] = "1"
+	state.Attributes["metadata.0.annotations.TestAnnotationOne"] = "one"
+	live.Annotations["TestAnnotationOne"] = "one"
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{
+				"name": "test",
+			},
+		},
+		"spec":    []interface{}{map[string]interface{}{"controller": "example.com/ingress-controller"}},
+		"default": true,
+	})
+	diff, err = r.Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ops, err = patchMetadata("metadata.0.", "/metadata/", d, nil, func() (metav1.Object, error) {
+		return live, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedOps = PatchOperations{
+		&RemoveOperation{Path: "/metadata/annotations/TestAnnotationOne"},
+	}
+	if !ops.Equal(expectedOps) {
+		t.Fatalf("Unexpected operations.\nExpected: %#v\nGiven:    %#v", expectedOps, ops)
+	}
+}
+
+func testAccCheckIngressClassDefaultAnnotation(obj *networking.IngressClass, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v := obj.Annotations[ingressClassDefaultAnnotation]; v != expected {
+			return fmt.Errorf("Expected %s annotation to be %q, given: %q", ingressClassDefaultAnnotation, expected, v)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesIngressClassDestroy(s *terraform.State) error {
+	client, err := ingressClassClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_ingress_class" {
+			continue
+		}
+
+		name := rs.Primary.ID
+
+		resp := &networking.IngressClass{}
+		err := client.Get(ctx, name, resp)
+		if err == nil {
+			if resp.Name == name {
+				return fmt.Errorf("Ingress class still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesIngressClassExists(n string, obj *networking.IngressClass) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, err := ingressClassClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		out := &networking.IngressClass{}
+		err = client.Get(ctx, rs.Primary.ID, out)
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesIngressClassConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_ingress_class" "test" {
+  metadata {
+    labels = {
+      TestLabelOne = "one"
+    }
+
+    name = "%s"
+  }
+
+  spec {
+    controller = "example.com/ingress-controller"
+  }
+}
+`, name)
+}
+
+func testAccKubernetesIngressClassConfig_annotated(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_ingress_class" "test" {
+  metadata {
+    annotations = {
+      TestAnnotationOne = "one"
+    }
+
+    name = "%s"
+  }
+
+  spec {
+    controller = "example.com/ingress-controller"
+
+    parameters {
+      api_group = "k8s.example.com"
+      kind      = "IngressParameters"
+      name      = "external-lb"
+    }
+  }
+
+  default = true
+}
+`, name)
+}
+
+func testAccKubernetesIngressClassConfig_modified(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_ingress_class" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    controller = "example.com/ingress-controller"
+
+    parameters {
+      api_group = "k8s.example.com"
+      kind      = "IngressParameters"
+      name      = "external-lb"
+    }
+  }
+
+  default = true
+}
+`, name)
+}
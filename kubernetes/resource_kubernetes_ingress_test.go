@@ -8,12 +8,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	api "k8s.io/api/extensions/v1beta1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	networking "k8s.io/api/networking/v1"
 )
 
 func TestAccKubernetesIngress_basic(t *testing.T) {
-	var conf api.Ingress
+	var conf networking.Ingress
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
@@ -39,6 +38,7 @@ func TestAccKubernetesIngress_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.host", "server.domain.com"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.path", "/.*"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.path_type", "ImplementationSpecific"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_name", "app2"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_port", "80"),
@@ -64,7 +64,7 @@ func TestAccKubernetesIngress_basic(t *testing.T) {
 }
 
 func TestAccKubernetesIngress_TLS(t *testing.T) {
-	var conf api.Ingress
+	var conf networking.Ingress
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
@@ -106,8 +106,33 @@ func TestAccKubernetesIngress_TLS(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesIngress_pathType(t *testing.T) {
+	var conf networking.Ingress
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     "kubernetes_ingress.test",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesIngressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesIngressConfig_pathType(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressExists("kubernetes_ingress.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.path_type", "Prefix"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_port", "http"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.1.path_type", "Exact"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.1.backend.0.service_port", "8080"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesIngress_InternalKey(t *testing.T) {
-	var conf api.Ingress
+	var conf networking.Ingress
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
@@ -149,7 +174,7 @@ func TestAccKubernetesIngress_InternalKey(t *testing.T) {
 }
 
 func TestAccKubernetesIngress_WaitForLoadBalancerGoogleCloud(t *testing.T) {
-	var conf api.Ingress
+	var conf networking.Ingress
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
@@ -170,7 +195,7 @@ func TestAccKubernetesIngress_WaitForLoadBalancerGoogleCloud(t *testing.T) {
 }
 
 func TestAccKubernetesIngress_stateUpgradeV0_loadBalancerIngress(t *testing.T) {
-	var conf1, conf2 api.Ingress
+	var conf1, conf2 networking.Ingress
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
@@ -196,7 +221,7 @@ func TestAccKubernetesIngress_stateUpgradeV0_loadBalancerIngress(t *testing.T) {
 	})
 }
 
-func testAccCheckKubernetesIngressForceNew(old, new *api.Ingress, wantNew bool) resource.TestCheckFunc {
+func testAccCheckKubernetesIngressForceNew(old, new *networking.Ingress, wantNew bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if wantNew {
 			if old.ObjectMeta.UID == new.ObjectMeta.UID {
@@ -212,11 +237,6 @@ func testAccCheckKubernetesIngressForceNew(old, new *api.Ingress, wantNew bool)
 }
 
 func testAccCheckKubernetesIngressDestroy(s *terraform.State) error {
-	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-
-	if err != nil {
-		return err
-	}
 	ctx := context.TODO()
 
 	for _, rs := range s.RootModule().Resources {
@@ -229,7 +249,11 @@ func testAccCheckKubernetesIngressDestroy(s *terraform.State) error {
 			return err
 		}
 
-		resp, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+		client, err := ingressClient(testAccProvider.Meta(), namespace)
+		if err != nil {
+			return err
+		}
+		resp, err := getIngress(ctx, client, name)
 		if err == nil {
 			if resp.Name == rs.Primary.ID {
 				return fmt.Errorf("Ingress still exists: %s", rs.Primary.ID)
@@ -240,25 +264,25 @@ func testAccCheckKubernetesIngressDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccCheckKubernetesIngressExists(n string, obj *api.Ingress) resource.TestCheckFunc {
+func testAccCheckKubernetesIngressExists(n string, obj *networking.Ingress) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-		if err != nil {
-			return err
-		}
 		ctx := context.TODO()
 
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
+		client, err := ingressClient(testAccProvider.Meta(), namespace)
+		if err != nil {
+			return err
+		}
 
-		out, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+		out, err := getIngress(ctx, client, name)
 		if err != nil {
 			return err
 		}
@@ -346,6 +370,37 @@ func testAccKubernetesIngressConfig_TLS_modified(name string) string {
 }`, name)
 }
 
+func testAccKubernetesIngressConfig_pathType(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_ingress" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    rule {
+      host = "server.domain.com"
+      http {
+        path {
+          path      = "/api"
+          path_type = "Prefix"
+          backend {
+            service_name = "api"
+            service_port = "http"
+          }
+        }
+        path {
+          path      = "/healthz"
+          path_type = "Exact"
+          backend {
+            service_name = "web"
+            service_port = 8080
+          }
+        }
+      }
+    }
+  }
+}`, name)
+}
+
 func testAccKubernetesIngressConfig_internalKey(name string) string {
 	return fmt.Sprintf(`resource "kubernetes_ingress" "test" {
   metadata {
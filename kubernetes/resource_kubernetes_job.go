@@ -33,18 +33,23 @@ func resourceKubernetesJob() *schema.Resource {
 				Type:    resourceKubernetesJobV0().CoreConfigSchema().ImpliedType(),
 				Upgrade: resourceKubernetesJobUpgradeV0,
 			},
+			{
+				Version: 1,
+				Type:    resourceKubernetesJobV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKubernetesJobUpgradeV1,
+			},
 		},
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
 			Update: schema.DefaultTimeout(1 * time.Minute),
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
-		Schema: resourceKubernetesJobSchemaV1(),
+		Schema: resourceKubernetesJobSchemaV2(),
 	}
 }
 
-func resourceKubernetesJobSchemaV1() map[string]*schema.Schema {
+func resourceKubernetesJobSchemaV2() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"metadata": jobMetadataSchema(),
 		"spec": {
@@ -71,20 +76,23 @@ func resourceKubernetesJobCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandJobSpec(d.Get("spec").([]interface{}))
+	job, err := expandJob(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	job := batchv1.Job{
-		ObjectMeta: metadata,
-		Spec:       spec,
-	}
-
 	log.Printf("[INFO] Creating new Job: %#v", job)
 
-	out, err := conn.BatchV1().Jobs(metadata.Namespace).Create(ctx, &job, metav1.CreateOptions{})
+	out := job
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = job
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create Job! API error: %s", err)
 	}
@@ -119,26 +127,43 @@ func resourceKubernetesJobUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-
-	if d.HasChange("spec") {
-		specOps, err := patchJobSpec("/spec", "spec.0.", d)
+	var out *batchv1.Job
+	if useServerSideApply(meta) {
+		out, err = expandJob(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = applyObject(ctx, meta, out)
+		if err != nil {
+			return diag.Errorf("Failed to update Job! API error: %s", err)
+		}
+	} else {
+		ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+			return conn.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		})
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		ops = append(ops, specOps...)
-	}
 
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return diag.Errorf("Failed to marshal update operations: %s", err)
-	}
+		if d.HasChange("spec") {
+			specOps, err := patchJobSpec("/spec", "spec.0.", d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			ops = append(ops, specOps...)
+		}
+
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
 
-	log.Printf("[INFO] Updating job %s: %#v", d.Id(), ops)
+		log.Printf("[INFO] Updating job %s: %#v", d.Id(), ops)
 
-	out, err := conn.BatchV1().Jobs(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to update Job! API error: %s", err)
+		out, err = conn.BatchV1().Jobs(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update Job! API error: %s", err)
+		}
 	}
 	log.Printf("[INFO] Submitted updated job: %#v", out)
 
@@ -154,6 +179,17 @@ func resourceKubernetesJobUpdate(ctx context.Context, d *schema.ResourceData, me
 	return resourceKubernetesJobRead(ctx, d, meta)
 }
 
+func expandJob(d *schema.ResourceData) (*batchv1.Job, error) {
+	spec, err := expandJobSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &batchv1.Job{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       spec,
+	}, nil
+}
+
 func resourceKubernetesJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesJobExists(ctx, d, meta)
 	if err != nil {
@@ -199,12 +235,12 @@ func resourceKubernetesJobRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	jobSpec, err := flattenJobSpec(job.Spec, d)
+	jobSpec, err := flattenJobSpec(job.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -228,7 +264,7 @@ func resourceKubernetesJobDelete(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	log.Printf("[INFO] Deleting job: %#v", name)
-	err = conn.BatchV1().Jobs(namespace).Delete(ctx, name, deleteOptions)
+	err = conn.BatchV1().Jobs(namespace).Delete(ctx, name, expandDeleteOptions(d, deleteOptions))
 	if err != nil {
 		return diag.Errorf("Failed to delete Job! API error: %s", err)
 	}
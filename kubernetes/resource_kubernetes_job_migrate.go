@@ -7,7 +7,7 @@ import (
 )
 
 func resourceKubernetesJobV0() *schema.Resource {
-	schemaV1 := resourceKubernetesJobSchemaV1()
+	schemaV1 := resourceKubernetesJobV1().Schema
 	schemaV0 := patchTemplatePodSpecWithResourcesFieldV0(schemaV1)
 	return &schema.Resource{Schema: schemaV0}
 }
@@ -15,3 +15,13 @@ func resourceKubernetesJobV0() *schema.Resource {
 func resourceKubernetesJobUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	return upgradeTemplatePodSpecWithResourcesFieldV0(ctx, rawState, meta)
 }
+
+func resourceKubernetesJobV1() *schema.Resource {
+	schemaV2 := resourceKubernetesJobSchemaV2()
+	schemaV1 := patchTemplatePodSpecWithSchedulingFieldsV1(schemaV2)
+	return &schema.Resource{Schema: schemaV1}
+}
+
+func resourceKubernetesJobUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return upgradeTemplatePodSpecWithSchedulingFieldsV1(ctx, rawState, meta)
+}
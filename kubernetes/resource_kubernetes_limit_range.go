@@ -84,17 +84,21 @@ func resourceKubernetesLimitRangeCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
+	limitRange, err := expandLimitRange(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	limitRange := api.LimitRange{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new limit range: %#v", limitRange)
-	out, err := conn.CoreV1().LimitRanges(metadata.Namespace).Create(ctx, &limitRange, metav1.CreateOptions{})
+	out := limitRange
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().LimitRanges(limitRange.Namespace).Create(ctx, limitRange, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = limitRange
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create limit range: %s", err)
 	}
@@ -104,6 +108,17 @@ func resourceKubernetesLimitRangeCreate(ctx context.Context, d *schema.ResourceD
 	return resourceKubernetesLimitRangeRead(ctx, d, meta)
 }
 
+func expandLimitRange(d *schema.ResourceData) (*api.LimitRange, error) {
+	spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
+	if err != nil {
+		return nil, err
+	}
+	return &api.LimitRange{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesLimitRangeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesLimitRangeExists(ctx, d, meta)
 	if err != nil {
@@ -129,7 +144,7 @@ func resourceKubernetesLimitRangeRead(ctx context.Context, d *schema.ResourceDat
 	}
 	log.Printf("[INFO] Received limit range: %#v", limitRange)
 
-	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -142,6 +157,14 @@ func resourceKubernetesLimitRangeRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceKubernetesLimitRangeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		limitRange, err := expandLimitRange(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return applyResourceUpdate(ctx, d, meta, limitRange, resourceKubernetesLimitRangeRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -152,7 +175,12 @@ func resourceKubernetesLimitRangeUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.CoreV1().LimitRanges(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("spec") {
 		spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
 		if err != nil {
@@ -190,7 +218,7 @@ func resourceKubernetesLimitRangeDelete(ctx context.Context, d *schema.ResourceD
 	}
 
 	log.Printf("[INFO] Deleting limit range: %#v", name)
-	err = conn.CoreV1().LimitRanges(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().LimitRanges(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
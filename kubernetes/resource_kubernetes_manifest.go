@@ -0,0 +1,338 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/client-go/dynamic"
+)
+
+func resourceKubernetesManifest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesManifestCreate,
+		ReadContext:   resourceKubernetesManifestRead,
+		UpdateContext: resourceKubernetesManifestUpdate,
+		DeleteContext: resourceKubernetesManifestDelete,
+		CustomizeDiff: resourceKubernetesManifestCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"manifest": {
+				Type:             schema.TypeMap,
+				Description:      "Kubernetes object as a map of its top-level fields, including `apiVersion`, `kind` and `metadata`, each of them JSON-encoded. Usually built with `{ for k, v in object : k => jsonencode(v) }` from an HCL object or `yamldecode` output. Only the fields declared here are managed.",
+				Required:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateFunc:     validateManifest,
+				DiffSuppressFunc: suppressEquivalentManifestValue,
+			},
+		},
+	}
+}
+
+func resourceKubernetesManifestCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("manifest") {
+		return nil
+	}
+	oldV, newV := d.GetChange("manifest")
+	if len(oldV.(map[string]interface{})) == 0 {
+		return nil
+	}
+
+	// Only the fields identifying the object are decoded,
+	// the others may not be known until apply.
+	oldIdentity := map[string]interface{}{}
+	newIdentity := map[string]interface{}{}
+	for _, f := range []string{"apiVersion", "kind", "metadata"} {
+		if !d.NewValueKnown("manifest." + f) {
+			return nil
+		}
+		oldIdentity[f] = oldV.(map[string]interface{})[f]
+		newIdentity[f] = newV.(map[string]interface{})[f]
+	}
+	oldObj, err := expandManifest(oldIdentity)
+	if err != nil {
+		return nil
+	}
+	newObj, err := expandManifest(newIdentity)
+	if err != nil {
+		return err
+	}
+	oldGVK, err := manifestGroupVersionKind(oldObj)
+	if err != nil {
+		return err
+	}
+	newGVK, err := manifestGroupVersionKind(newObj)
+	if err != nil {
+		return err
+	}
+
+	// Moving between versions of the same API group keeps the object,
+	// everything else which identifies it requires a replacement.
+	if oldGVK.GroupKind() != newGVK.GroupKind() ||
+		oldObj.GetName() != newObj.GetName() ||
+		(newObj.GetNamespace() != "" && oldObj.GetNamespace() != newObj.GetNamespace()) {
+		return d.ForceNew("manifest")
+	}
+	return nil
+}
+
+func resourceKubernetesManifestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	obj, err := expandManifest(d.Get("manifest").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := manifestResourceClient(meta, obj)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new %s: %#v", obj.GetKind(), obj)
+	var out *unstructured.Unstructured
+	if useServerSideApply(meta) {
+		out, err = applyNewUnstructured(ctx, d, meta, client, obj)
+	} else {
+		out, err = client.Create(ctx, obj, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out, err = adoptUnstructured(ctx, d, meta, client, obj)
+		}
+	}
+	if err != nil {
+		return diag.Errorf("Failed to create %s: %s", obj.GetKind(), err)
+	}
+	log.Printf("[INFO] Submitted new %s: %#v", out.GetKind(), out)
+
+	d.SetId(buildManifestId(out.GetAPIVersion(), out.GetKind(), out.GetNamespace(), out.GetName()))
+
+	return resourceKubernetesManifestRead(ctx, d, meta)
+}
+
+func resourceKubernetesManifestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesManifestExists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	apiVersion, kind, namespace, name, err := manifestIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := manifestResourceClient(meta, manifestObjectFromId(apiVersion, kind, namespace, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading %s %s", kind, name)
+	out, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received %s: %#v", kind, out)
+
+	var manifest map[string]interface{}
+	if v := d.Get("manifest").(map[string]interface{}); len(v) > 0 {
+		manifest, err = decodeManifest(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	m, err := encodeManifest(flattenManifest(out.Object, manifest))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("manifest", m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesManifestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oldV, newV := d.GetChange("manifest")
+	obj, err := expandManifest(newV.(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := manifestResourceClient(meta, obj)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if useServerSideApply(meta) {
+		out, err := applyUnstructured(ctx, meta, client, obj)
+		if err != nil {
+			return diag.Errorf("Failed to update %s: %s", obj.GetKind(), err)
+		}
+		log.Printf("[INFO] Submitted updated %s: %#v", out.GetKind(), out)
+
+		d.SetId(buildManifestId(out.GetAPIVersion(), out.GetKind(), out.GetNamespace(), out.GetName()))
+		return resourceKubernetesManifestRead(ctx, d, meta)
+	}
+
+	current, err := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	currentData, err := json.Marshal(current.Object)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	originalObj, err := decodeManifest(oldV.(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	original, err := json.Marshal(originalObj)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	modified, err := json.Marshal(obj.Object)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A three-way merge only touches the fields that changed in the manifest,
+	// and leaves fields set by other clients alone.
+	data, err := jsonmergepatch.CreateThreeWayJSONMergePatch(original, modified, currentData)
+	if err != nil {
+		return diag.Errorf("Failed to compute update patch: %s", err)
+	}
+
+	log.Printf("[INFO] Updating %s %q: %v", obj.GetKind(), obj.GetName(), string(data))
+	out, err := client.Patch(ctx, obj.GetName(), pkgApi.MergePatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update %s: %s", obj.GetKind(), err)
+	}
+	log.Printf("[INFO] Submitted updated %s: %#v", out.GetKind(), out)
+
+	d.SetId(buildManifestId(out.GetAPIVersion(), out.GetKind(), out.GetNamespace(), out.GetName()))
+
+	return resourceKubernetesManifestRead(ctx, d, meta)
+}
+
+func resourceKubernetesManifestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiVersion, kind, namespace, name, err := manifestIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := manifestResourceClient(meta, manifestObjectFromId(apiVersion, kind, namespace, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting %s: %#v", kind, name)
+	err = client.Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[INFO] %s %s already deleted", kind, name)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("%s %s still exists", kind, name)
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] %s %s deleted", kind, name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesManifestExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	apiVersion, kind, namespace, name, err := manifestIdParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+	client, err := manifestResourceClient(meta, manifestObjectFromId(apiVersion, kind, namespace, name))
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking %s %s", kind, name)
+	_, err = client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+func manifestObjectFromId(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+// manifestResourceClient resolves the resource serving the object's kind
+// through discovery and returns a dynamic client scoped to it.
+// The namespace of namespaced objects defaults to "default".
+func manifestResourceClient(meta interface{}, obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	gvk, err := manifestGroupVersionKind(obj)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := restMappingForGroupVersionKind(meta, gvk)
+	if err != nil {
+		return nil, err
+	}
+	client, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() != apimeta.RESTScopeNameNamespace {
+		if obj.GetNamespace() != "" {
+			return nil, fmt.Errorf("%s is cluster-scoped and cannot set %q", gvk.Kind, "metadata.namespace")
+		}
+		return client.Resource(mapping.Resource), nil
+	}
+
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace("default")
+	}
+	return client.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+}
@@ -0,0 +1,143 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesManifest_configMap(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesManifestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesManifestConfig_configMap(name, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesManifestConfigMapExists(resourceName, &conf),
+					testAccCheckKubernetesManifestConfigMapData(&conf, "one"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("v1,ConfigMap,default,%s", name)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("v1,ConfigMap,default,%s", name),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesManifestConfig_configMap(name, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesManifestConfigMapExists(resourceName, &conf),
+					testAccCheckKubernetesManifestConfigMapData(&conf, "two"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesManifestConfigMapData(conf *api.ConfigMap, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v := conf.Data["one"]; v != value {
+			return fmt.Errorf("Expected data.one to be %q, given: %q", value, v)
+		}
+		if v := conf.Annotations["team"]; v != "infra" {
+			return fmt.Errorf("Expected annotation team to be %q, given: %q", "infra", v)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesManifestConfigMapExists(n string, obj *api.ConfigMap) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		_, _, namespace, name, err := manifestIdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		out, err := conn.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccCheckKubernetesManifestDestroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_manifest" {
+			continue
+		}
+
+		_, kind, namespace, name, err := manifestIdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if kind != "ConfigMap" {
+			continue
+		}
+
+		resp, err := conn.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.Name == name {
+				return fmt.Errorf("Config Map still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccKubernetesManifestConfig_configMap(name, value string) string {
+	return fmt.Sprintf(`locals {
+  config_map = {
+    apiVersion = "v1"
+    kind       = "ConfigMap"
+    metadata = {
+      name      = "%s"
+      namespace = "default"
+      annotations = {
+        team = "infra"
+      }
+    }
+    data = {
+      one = "%s"
+    }
+  }
+}
+
+resource "kubernetes_manifest" "test" {
+  manifest = { for k, v in local.config_map : k => jsonencode(v) }
+}
+`, name, value)
+}
@@ -10,6 +10,7 @@ import (
 	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
 
 	copier "github.com/jinzhu/copier"
@@ -125,10 +126,7 @@ func resourceKubernetesMutatingWebhookConfigurationCreate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	cfg := admissionregistrationv1.MutatingWebhookConfiguration{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
-		Webhooks:   expandMutatingWebhooks(d.Get("webhook").([]interface{})),
-	}
+	cfg := expandMutatingWebhookConfiguration(d)
 
 	log.Printf("[INFO] Creating new MutatingWebhookConfiguration: %#v", cfg)
 
@@ -138,14 +136,26 @@ func resourceKubernetesMutatingWebhookConfigurationCreate(ctx context.Context, d
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if useadmissionregistrationv1beta1 {
+	if useServerSideApply(meta) {
+		res, err = applyMutatingWebhookConfiguration(cfg, useadmissionregistrationv1beta1, func(obj runtime.Object) error {
+			return applyNewObject(ctx, d, meta, obj)
+		})
+	} else if useadmissionregistrationv1beta1 {
 		requestv1beta1 := &admissionregistrationv1beta1.MutatingWebhookConfiguration{}
 		responsev1beta1 := &admissionregistrationv1beta1.MutatingWebhookConfiguration{}
 		copier.Copy(requestv1beta1, cfg)
 		responsev1beta1, err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Create(ctx, requestv1beta1, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			responsev1beta1 = requestv1beta1
+			err = adoptObject(ctx, d, meta, responsev1beta1)
+		}
 		copier.Copy(res, responsev1beta1)
 	} else {
-		res, err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Create(ctx, &cfg, metav1.CreateOptions{})
+		res, err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Create(ctx, cfg, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			res = cfg
+			err = adoptObject(ctx, d, meta, res)
+		}
 	}
 
 	if err != nil {
@@ -159,6 +169,32 @@ func resourceKubernetesMutatingWebhookConfigurationCreate(ctx context.Context, d
 	return resourceKubernetesMutatingWebhookConfigurationRead(ctx, d, meta)
 }
 
+func expandMutatingWebhookConfiguration(d *schema.ResourceData) *admissionregistrationv1.MutatingWebhookConfiguration {
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Webhooks:   expandMutatingWebhooks(d.Get("webhook").([]interface{})),
+	}
+}
+
+// applyMutatingWebhookConfiguration creates or updates cfg with the server-side apply function,
+// through admissionregistration.k8s.io/v1beta1 on clusters which do not serve v1.
+func applyMutatingWebhookConfiguration(cfg *admissionregistrationv1.MutatingWebhookConfiguration, v1beta1 bool, apply func(obj runtime.Object) error) (*admissionregistrationv1.MutatingWebhookConfiguration, error) {
+	if !v1beta1 {
+		err := apply(cfg)
+		return cfg, err
+	}
+
+	requestv1beta1 := &admissionregistrationv1beta1.MutatingWebhookConfiguration{}
+	copier.Copy(requestv1beta1, cfg)
+	err := apply(requestv1beta1)
+	if err != nil {
+		return nil, err
+	}
+	res := &admissionregistrationv1.MutatingWebhookConfiguration{}
+	copier.Copy(res, requestv1beta1)
+	return res, nil
+}
+
 func resourceKubernetesMutatingWebhookConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesMutatingWebhookConfigurationExists(ctx, d, meta)
 	if err != nil {
@@ -192,7 +228,7 @@ func resourceKubernetesMutatingWebhookConfigurationRead(ctx context.Context, d *
 		return diag.FromErr(err)
 	}
 
-	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d, meta))
 	if err != nil {
 		return nil
 	}
@@ -213,7 +249,33 @@ func resourceKubernetesMutatingWebhookConfigurationUpdate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if useServerSideApply(meta) {
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = applyMutatingWebhookConfiguration(expandMutatingWebhookConfiguration(d), useadmissionregistrationv1beta1, func(obj runtime.Object) error {
+			return applyObject(ctx, meta, obj)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceKubernetesMutatingWebhookConfigurationRead(ctx, d, meta)
+	}
+
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+		if err != nil {
+			return nil, err
+		}
+		if useadmissionregistrationv1beta1 {
+			return conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(ctx, d.Id(), metav1.GetOptions{})
+		}
+		return conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, d.Id(), metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	if d.HasChange("webhook") {
 		op := &ReplaceOperation{
@@ -281,9 +343,9 @@ func resourceKubernetesMutatingWebhookConfigurationDelete(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 	if useadmissionregistrationv1beta1 {
-		err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+		err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	} else {
-		err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+		err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	}
 	if err != nil {
 		return diag.FromErr(err)
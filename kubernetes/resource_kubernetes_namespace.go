@@ -3,16 +3,19 @@ package kubernetes
 import (
 	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesNamespace() *schema.Resource {
@@ -27,6 +30,12 @@ func resourceKubernetesNamespace() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("namespace", true),
+			"force_remove_finalizers": {
+				Type:        schema.TypeBool,
+				Description: "Remove the finalizers of the namespace through the `finalize` subresource when it is still terminating once the delete timeout is over. Resources left in the namespace may be orphaned. Defaults to false.",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(5 * time.Minute),
@@ -40,12 +49,18 @@ func resourceKubernetesNamespaceCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	namespace := api.Namespace{
-		ObjectMeta: metadata,
-	}
+	namespace := expandNamespace(d)
 	log.Printf("[INFO] Creating new namespace: %#v", namespace)
-	out, err := conn.CoreV1().Namespaces().Create(ctx, &namespace, metav1.CreateOptions{})
+	out := namespace
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = namespace
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -55,6 +70,12 @@ func resourceKubernetesNamespaceCreate(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesNamespaceRead(ctx, d, meta)
 }
 
+func expandNamespace(d *schema.ResourceData) *api.Namespace {
+	return &api.Namespace{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+	}
+}
+
 func resourceKubernetesNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesNamespaceExists(ctx, d, meta)
 	if err != nil {
@@ -76,7 +97,7 @@ func resourceKubernetesNamespaceRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received namespace: %#v", namespace)
-	err = d.Set("metadata", flattenMetadata(namespace.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(namespace.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -85,12 +106,21 @@ func resourceKubernetesNamespaceRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandNamespace(d), resourceKubernetesNamespaceRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.CoreV1().Namespaces().Get(ctx, d.Id(), metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
@@ -115,7 +145,7 @@ func resourceKubernetesNamespaceDelete(ctx context.Context, d *schema.ResourceDa
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting namespace: %#v", name)
-	err = conn.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().Namespaces().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -141,7 +171,32 @@ func resourceKubernetesNamespaceDelete(ctx context.Context, d *schema.ResourceDa
 	}
 	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return diag.FromErr(err)
+		if _, ok := err.(*resource.TimeoutError); !ok && err != context.DeadlineExceeded {
+			return diag.FromErr(err)
+		}
+		dctx, cancel := diagnosticsContext(ctx)
+		defer cancel()
+
+		diagnostics := namespaceDeletionDiagnostics(dctx, meta, name)
+		if !d.Get("force_remove_finalizers").(bool) {
+			return diag.Errorf("Namespace %s is stuck terminating: %s%s", name, err, diagnostics)
+		}
+		fctx, cancel := diagnosticsContext(ctx)
+		defer cancel()
+		err = forceRemoveNamespaceFinalizers(fctx, conn, name)
+		if err != nil {
+			return diag.Errorf("Namespace %s is stuck terminating%s\n\nFailed to remove its finalizers: %s", name, diagnostics, err)
+		}
+		log.Printf("[INFO] Namespace %s deleted after removing its finalizers", name)
+
+		d.SetId("")
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Removed the finalizers of namespace %s", name),
+				Detail:   fmt.Sprintf("The namespace did not finish terminating in time. Resources left in it may be orphaned.%s", diagnostics),
+			},
+		}
 	}
 	log.Printf("[INFO] Namespace %s deleted", name)
 
@@ -149,6 +204,40 @@ func resourceKubernetesNamespaceDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// forceRemoveNamespaceFinalizers empties the finalizers of a terminating namespace,
+// so it is removed without waiting for the resources in it to be cleaned up.
+func forceRemoveNamespaceFinalizers(ctx context.Context, conn *kubernetes.Clientset, name string) error {
+	ns, err := conn.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if len(ns.ObjectMeta.Finalizers) > 0 {
+		return fmt.Errorf("the namespace has metadata finalizers which are not removed through the finalize subresource: %s",
+			strings.Join(ns.ObjectMeta.Finalizers, ", "))
+	}
+
+	log.Printf("[INFO] Removing finalizers of namespace %s: %v", name, ns.Spec.Finalizers)
+	ns.Spec.Finalizers = nil
+	_, err = conn.CoreV1().Namespaces().Finalize(ctx, ns, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return resource.RetryContext(ctx, diagnosticsTimeout, func() *resource.RetryError {
+		_, err := conn.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Namespace %s still exists", name))
+	})
+}
+
 func resourceKubernetesNamespaceExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
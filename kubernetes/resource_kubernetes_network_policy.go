@@ -240,18 +240,21 @@ func resourceKubernetesNetworkPolicyCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandNetworkPolicySpec(d.Get("spec").([]interface{}))
+	svc, err := expandNetworkPolicy(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	svc := api.NetworkPolicy{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new network policy: %#v", svc)
-	out, err := conn.NetworkingV1().NetworkPolicies(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
+	out := svc
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.NetworkingV1().NetworkPolicies(svc.Namespace).Create(ctx, svc, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = svc
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -262,6 +265,17 @@ func resourceKubernetesNetworkPolicyCreate(ctx context.Context, d *schema.Resour
 	return resourceKubernetesNetworkPolicyRead(ctx, d, meta)
 }
 
+func expandNetworkPolicy(d *schema.ResourceData) (*api.NetworkPolicy, error) {
+	spec, err := expandNetworkPolicySpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.NetworkPolicy{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesNetworkPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesNetworkPolicyExists(ctx, d, meta)
 	if err != nil {
@@ -286,7 +300,7 @@ func resourceKubernetesNetworkPolicyRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received network policy: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -302,6 +316,14 @@ func resourceKubernetesNetworkPolicyRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubernetesNetworkPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		svc, err := expandNetworkPolicy(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return applyResourceUpdate(ctx, d, meta, svc, resourceKubernetesNetworkPolicyRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -312,7 +334,12 @@ func resourceKubernetesNetworkPolicyUpdate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.NetworkingV1().NetworkPolicies(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("spec") {
 		diffOps, err := patchNetworkPolicySpec("spec.0.", "/spec", d)
 		if err != nil {
@@ -346,7 +373,7 @@ func resourceKubernetesNetworkPolicyDelete(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Deleting network policy: %#v", name)
-	err = conn.NetworkingV1().NetworkPolicies(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.NetworkingV1().NetworkPolicies(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
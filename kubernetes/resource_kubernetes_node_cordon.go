@@ -0,0 +1,193 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesNodeCordon() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesNodeCordonCreate,
+		ReadContext:   resourceKubernetesNodeCordonRead,
+		UpdateContext: resourceKubernetesNodeCordonUpdate,
+		DeleteContext: resourceKubernetesNodeCordonDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": nodeMetadataSchema(),
+			"previously_unschedulable": {
+				Type:        schema.TypeBool,
+				Description: "Whether the node was already cordoned when the resource was created, in which case it's left cordoned when the resource is destroyed.",
+				Computed:    true,
+			},
+			"drain": {
+				Type:        schema.TypeList,
+				Description: "Evict the pods running on the node once it is cordoned. Evictions respect PodDisruptionBudgets. The node is only drained when the resource is created.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ignore_daemonsets": {
+							Type:        schema.TypeBool,
+							Description: "Leave the pods managed by a DaemonSet on the node. When false, the drain fails if there are such pods.",
+							Optional:    true,
+							Default:     true,
+						},
+						"delete_emptydir_data": {
+							Type:        schema.TypeBool,
+							Description: "Evict pods using emptyDir volumes, whose data is lost. When false, the drain fails if there are such pods.",
+							Optional:    true,
+							Default:     false,
+						},
+						"force": {
+							Type:        schema.TypeBool,
+							Description: "Evict pods which are not managed by a controller, and are not recreated elsewhere. When false, the drain fails if there are such pods.",
+							Optional:    true,
+							Default:     false,
+						},
+						"grace_period_seconds": {
+							Type:        schema.TypeInt,
+							Description: "Period of time in seconds given to each pod to terminate gracefully. If negative, the default value specified in the pod will be used.",
+							Optional:    true,
+							Default:     -1,
+						},
+						"pod_selector": {
+							Type:        schema.TypeString,
+							Description: "Label selector to filter the pods to evict.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesNodeCordonCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("metadata.0.name").(string)
+	node, err := conn.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to read node %s: %s", name, err)
+	}
+	if node.Spec.Unschedulable {
+		log.Printf("[INFO] Node %s is already cordoned", name)
+	} else {
+		err = setNodeUnschedulable(ctx, meta, name, true)
+		if err != nil {
+			return diag.Errorf("Failed to cordon node %s: %s", name, err)
+		}
+		log.Printf("[INFO] Node %s cordoned", name)
+	}
+	d.SetId(name)
+	err = d.Set("previously_unschedulable", node.Spec.Unschedulable)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if o := expandNodeDrainOptions(d.Get("drain").([]interface{})); o != nil {
+		err = drainNode(ctx, conn, name, o, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("Failed to drain node %s: %s", name, err)
+		}
+		log.Printf("[INFO] Node %s drained", name)
+	}
+
+	return resourceKubernetesNodeCordonRead(ctx, d, meta)
+}
+
+func resourceKubernetesNodeCordonRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Reading node %s", name)
+	node, err := conn.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[INFO] Node %s not found, removing from state", name)
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	if !node.Spec.Unschedulable {
+		log.Printf("[INFO] Node %s was uncordoned outside of Terraform, removing from state", name)
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	err = d.Set("metadata", flattenNodeMetadata(node.Name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesNodeCordonUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only the drain options can change, and they only apply on creation
+	return resourceKubernetesNodeCordonRead(ctx, d, meta)
+}
+
+func resourceKubernetesNodeCordonDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("previously_unschedulable").(bool) {
+		log.Printf("[INFO] Node %s was cordoned before the resource was created, leaving it cordoned", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	err := setNodeUnschedulable(ctx, meta, d.Id(), false)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to uncordon node %s: %s", d.Id(), err)
+	}
+	log.Printf("[INFO] Node %s uncordoned", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func setNodeUnschedulable(ctx context.Context, meta interface{}, name string, unschedulable bool) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	ops := PatchOperations{
+		&AddOperation{
+			Path:  "/spec/unschedulable",
+			Value: unschedulable,
+		},
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Patching node %q: %v", name, string(data))
+	_, err = conn.CoreV1().Nodes().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	return err
+}
@@ -0,0 +1,175 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesNodeCordon_basic(t *testing.T) {
+	nodeName := testAccFirstNodeName(t)
+	resourceName := "kubernetes_node_cordon.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesNodeCordonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNodeCordonConfig_basic(nodeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", nodeName),
+					resource.TestCheckResourceAttr(resourceName, "drain.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "previously_unschedulable", "false"),
+					testAccCheckKubernetesNodeUnschedulable(nodeName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"drain", "previously_unschedulable"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesNodeUnschedulable(nodeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		node, err := conn.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !node.Spec.Unschedulable {
+			return fmt.Errorf("Expected node %s to be unschedulable", nodeName)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesNodeCordonDestroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_node_cordon" {
+			continue
+		}
+		node, err := conn.CoreV1().Nodes().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if node.Spec.Unschedulable {
+			return fmt.Errorf("Node is still cordoned: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccKubernetesNodeCordonConfig_basic(nodeName string) string {
+	// Only evict pods which do not exist, the rest of the tests share the node
+	return fmt.Sprintf(`resource "kubernetes_node_cordon" "test" {
+  metadata {
+    name = "%s"
+  }
+  drain {
+    pod_selector = "tf-acc-test-drain=none"
+  }
+}
+`, nodeName)
+}
+
+func TestPodsToEvict(t *testing.T) {
+	isController := true
+	pod := func(name string, ownerKind string, volumes ...api.Volume) api.Pod {
+		p := api.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec:       api.PodSpec{Volumes: volumes},
+			Status:     api.PodStatus{Phase: api.PodRunning},
+		}
+		if ownerKind != "" {
+			p.OwnerReferences = []metav1.OwnerReference{{Kind: ownerKind, Name: "owner", Controller: &isController}}
+		}
+		return p
+	}
+	emptyDir := api.Volume{Name: "cache", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}}
+
+	mirror := pod("mirror", "")
+	mirror.Annotations = map[string]string{api.MirrorPodAnnotationKey: "hash"}
+	completed := pod("completed", "")
+	completed.Status.Phase = api.PodSucceeded
+	pods := []api.Pod{
+		pod("web", "ReplicaSet"),
+		pod("agent", "DaemonSet"),
+		mirror,
+		completed,
+	}
+
+	evict, err := podsToEvict(pods, &nodeDrainOptions{ignoreDaemonSets: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, p := range evict {
+		names = append(names, p.Name)
+	}
+	if strings.Join(names, ",") != "web,completed" {
+		t.Fatalf("Unexpected pods to evict: %q", names)
+	}
+
+	pods = append(pods, pod("bare", ""), pod("cache", "ReplicaSet", emptyDir))
+	_, err = podsToEvict(pods, &nodeDrainOptions{})
+	if err == nil {
+		t.Fatal("Expected the drain to be refused")
+	}
+	for _, s := range []string{"default/agent is managed by a DaemonSet", "default/bare is not managed by a controller", "default/cache uses emptyDir volumes"} {
+		if !strings.Contains(err.Error(), s) {
+			t.Fatalf("Expected error to contain %q, given: %s", s, err)
+		}
+	}
+
+	evict, err = podsToEvict(pods, &nodeDrainOptions{ignoreDaemonSets: true, force: true, deleteEmptyDirData: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evict) != 4 {
+		t.Fatalf("Expected 4 pods to evict, given: %d", len(evict))
+	}
+}
+
+func TestEvictionGroupVersionFromResources(t *testing.T) {
+	list := &metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "Pod"},
+			{Name: "pods/eviction", Group: "policy", Version: "v1", Kind: "Eviction"},
+		},
+	}
+	if gv := evictionGroupVersionFromResources(list); gv != "policy/v1" {
+		t.Fatalf("Expected policy/v1, given: %s", gv)
+	}
+
+	list.APIResources[1].Version = "v1beta1"
+	if gv := evictionGroupVersionFromResources(list); gv != "policy/v1beta1" {
+		t.Fatalf("Expected policy/v1beta1, given: %s", gv)
+	}
+
+	list.APIResources[1].Group, list.APIResources[1].Version = "", ""
+	if gv := evictionGroupVersionFromResources(list); gv != "policy/v1beta1" {
+		t.Fatalf("Expected policy/v1beta1 when the kind is not reported, given: %s", gv)
+	}
+}
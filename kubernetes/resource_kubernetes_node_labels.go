@@ -0,0 +1,130 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesNodeLabels() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesNodeLabelsCreate,
+		ReadContext:   resourceKubernetesNodeLabelsRead,
+		UpdateContext: resourceKubernetesNodeLabelsUpdate,
+		DeleteContext: resourceKubernetesNodeLabelsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": nodeMetadataSchema(),
+			"labels": {
+				Type:         schema.TypeMap,
+				Description:  "Labels to set on the node. Only these keys are managed, other labels of the node are left untouched. More info: http://kubernetes.io/docs/user-guide/labels",
+				Required:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateLabels,
+			},
+		},
+	}
+}
+
+func resourceKubernetesNodeLabelsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("metadata.0.name").(string)
+	diags := patchNodeLabels(ctx, meta, name, map[string]interface{}{}, d.Get("labels").(map[string]interface{}))
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(name)
+
+	return resourceKubernetesNodeLabelsRead(ctx, d, meta)
+}
+
+func resourceKubernetesNodeLabelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Reading node %s", name)
+	node, err := conn.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[INFO] Node %s not found, removing its labels from state", name)
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("metadata", flattenNodeMetadata(node.Name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only report the labels owned by this resource
+	labels := make(map[string]interface{})
+	for k := range d.Get("labels").(map[string]interface{}) {
+		if v, ok := node.Labels[k]; ok {
+			labels[k] = v
+		}
+	}
+	err = d.Set("labels", labels)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesNodeLabelsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("labels") {
+		oldV, newV := d.GetChange("labels")
+		diags := patchNodeLabels(ctx, meta, d.Id(), oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceKubernetesNodeLabelsRead(ctx, d, meta)
+}
+
+func resourceKubernetesNodeLabelsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := patchNodeLabels(ctx, meta, d.Id(), d.Get("labels").(map[string]interface{}), map[string]interface{}{})
+	if diags.HasError() {
+		return diags
+	}
+	log.Printf("[INFO] Labels of node %s removed", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func patchNodeLabels(ctx context.Context, meta interface{}, name string, oldV, newV map[string]interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := diffStringMapKeys("/metadata/labels", oldV, newV)
+	if len(ops) == 0 {
+		return nil
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating labels of node %q: %v", name, string(data))
+	_, err = conn.CoreV1().Nodes().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) && len(newV) == 0 {
+			return nil
+		}
+		return diag.Errorf("Failed to update labels of node %s: %s", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesNodeLabels_basic(t *testing.T) {
+	nodeName := testAccFirstNodeName(t)
+	prefix := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_node_labels.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesNodeLabelsDestroy(prefix),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNodeLabelsConfig_basic(nodeName, prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", nodeName),
+					resource.TestCheckResourceAttr(resourceName, "labels.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "labels.example.com/"+prefix+"-one", "one"),
+					resource.TestCheckResourceAttr(resourceName, "labels.example.com/"+prefix+"-two", "two"),
+					testAccCheckKubernetesNodeLabelsPreserved(nodeName),
+				),
+			},
+			{
+				Config: testAccKubernetesNodeLabelsConfig_modified(nodeName, prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "labels.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "labels.example.com/"+prefix+"-two", "deux"),
+					resource.TestCheckResourceAttr(resourceName, "labels.example.com/"+prefix+"-three", "three"),
+					testAccCheckKubernetesNodeLabelsPreserved(nodeName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKubernetesNodeLabelsPreserved checks the labels set by the kubelet were left alone
+func testAccCheckKubernetesNodeLabelsPreserved(nodeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		node, err := conn.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if _, ok := node.Labels["kubernetes.io/hostname"]; !ok {
+			return fmt.Errorf("Expected label kubernetes.io/hostname to be preserved, given: %#v", node.Labels)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesNodeLabelsDestroy(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "kubernetes_node_labels" {
+				continue
+			}
+			node, err := conn.CoreV1().Nodes().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			for _, k := range []string{"one", "two", "three"} {
+				if _, ok := node.Labels["example.com/"+prefix+"-"+k]; ok {
+					return fmt.Errorf("Node label still exists: example.com/%s-%s", prefix, k)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesNodeLabelsConfig_basic(nodeName, prefix string) string {
+	return fmt.Sprintf(`resource "kubernetes_node_labels" "test" {
+  metadata {
+    name = "%s"
+  }
+  labels = {
+    "example.com/%s-one" = "one"
+    "example.com/%s-two" = "two"
+  }
+}
+`, nodeName, prefix, prefix)
+}
+
+func testAccKubernetesNodeLabelsConfig_modified(nodeName, prefix string) string {
+	return fmt.Sprintf(`resource "kubernetes_node_labels" "test" {
+  metadata {
+    name = "%s"
+  }
+  labels = {
+    "example.com/%s-two"   = "deux"
+    "example.com/%s-three" = "three"
+  }
+}
+`, nodeName, prefix, prefix)
+}
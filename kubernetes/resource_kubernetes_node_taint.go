@@ -0,0 +1,185 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+func resourceKubernetesNodeTaint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesNodeTaintCreate,
+		ReadContext:   resourceKubernetesNodeTaintRead,
+		UpdateContext: resourceKubernetesNodeTaintUpdate,
+		DeleteContext: resourceKubernetesNodeTaintDelete,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": nodeMetadataSchema(),
+			"taint": {
+				Type:        schema.TypeList,
+				Description: "Taints to set on the node. Only these taints, identified by key and effect, are managed. Other taints of the node are left untouched. More info: https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Description:  "The taint key to be applied to the node.",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The taint value corresponding to the taint key.",
+							Optional:    true,
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Description:  "The effect of the taint on pods that do not tolerate the taint. Valid effects are NoSchedule, PreferNoSchedule and NoExecute.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NoSchedule", "PreferNoSchedule", "NoExecute"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesNodeTaintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("metadata.0.name").(string)
+	err := updateNodeTaints(ctx, meta, name, nil, expandNodeTaints(d.Get("taint").([]interface{})))
+	if err != nil {
+		return diag.Errorf("Failed to set taints on node %s: %s", name, err)
+	}
+	d.SetId(name)
+
+	return resourceKubernetesNodeTaintRead(ctx, d, meta)
+}
+
+func resourceKubernetesNodeTaintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Reading node %s", name)
+	node, err := conn.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[INFO] Node %s not found, removing its taints from state", name)
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("metadata", flattenNodeMetadata(node.Name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only report the taints owned by this resource
+	owned := expandNodeTaints(d.Get("taint").([]interface{}))
+	err = d.Set("taint", flattenNodeTaints(filterOwnedTaints(node.Spec.Taints, owned)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesNodeTaintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("taint") {
+		oldV, newV := d.GetChange("taint")
+		err := updateNodeTaints(ctx, meta, d.Id(), expandNodeTaints(oldV.([]interface{})), expandNodeTaints(newV.([]interface{})))
+		if err != nil {
+			return diag.Errorf("Failed to update taints of node %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceKubernetesNodeTaintRead(ctx, d, meta)
+}
+
+func resourceKubernetesNodeTaintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := updateNodeTaints(ctx, meta, d.Id(), expandNodeTaints(d.Get("taint").([]interface{})), nil)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to remove taints from node %s: %s", d.Id(), err)
+	}
+	log.Printf("[INFO] Taints of node %s removed", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+// updateNodeTaints replaces the taints previously owned with the new ones,
+// retrying when the node was modified concurrently, e.g. by the kubelet.
+func updateNodeTaints(ctx context.Context, meta interface{}, name string, oldOwned, newOwned []api.Taint) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := conn.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		node.Spec.Taints = mergeNodeTaints(node.Spec.Taints, oldOwned, newOwned)
+		log.Printf("[INFO] Updating taints of node %s: %#v", name, node.Spec.Taints)
+		_, err = conn.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// mergeNodeTaints keeps the current taints which are not owned, and appends the
+// new owned taints. Taints are identified by key and effect, like kubectl does.
+func mergeNodeTaints(current, oldOwned, newOwned []api.Taint) []api.Taint {
+	var taints []api.Taint
+	for _, t := range current {
+		if taintIndex(oldOwned, t) >= 0 || taintIndex(newOwned, t) >= 0 {
+			continue
+		}
+		taints = append(taints, t)
+	}
+	for _, t := range newOwned {
+		// Keep the time a NoExecute taint was added as long as it is unchanged
+		if i := taintIndex(current, t); i >= 0 && current[i].Value == t.Value {
+			t.TimeAdded = current[i].TimeAdded
+		}
+		taints = append(taints, t)
+	}
+	return taints
+}
+
+func filterOwnedTaints(taints, owned []api.Taint) []api.Taint {
+	var filtered []api.Taint
+	for _, t := range taints {
+		if taintIndex(owned, t) >= 0 {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
+func taintIndex(taints []api.Taint, t api.Taint) int {
+	for i, c := range taints {
+		if c.MatchTaint(&t) {
+			return i
+		}
+	}
+	return -1
+}
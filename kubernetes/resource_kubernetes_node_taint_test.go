@@ -0,0 +1,116 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesNodeTaint_basic(t *testing.T) {
+	nodeName := testAccFirstNodeName(t)
+	key := fmt.Sprintf("example.com/tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_node_taint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesNodeTaintDestroy(key),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNodeTaintConfig_basic(nodeName, key, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", nodeName),
+					resource.TestCheckResourceAttr(resourceName, "taint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "taint.0.key", key),
+					resource.TestCheckResourceAttr(resourceName, "taint.0.value", "one"),
+					resource.TestCheckResourceAttr(resourceName, "taint.0.effect", "PreferNoSchedule"),
+				),
+			},
+			{
+				Config: testAccKubernetesNodeTaintConfig_basic(nodeName, key, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "taint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "taint.0.value", "two"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesNodeTaintDestroy(key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "kubernetes_node_taint" {
+				continue
+			}
+			node, err := conn.CoreV1().Nodes().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if taintIndex(node.Spec.Taints, api.Taint{Key: key, Effect: api.TaintEffectPreferNoSchedule}) >= 0 {
+				return fmt.Errorf("Node taint still exists: %s", key)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesNodeTaintConfig_basic(nodeName, key, value string) string {
+	return fmt.Sprintf(`resource "kubernetes_node_taint" "test" {
+  metadata {
+    name = "%s"
+  }
+  taint {
+    key    = "%s"
+    value  = "%s"
+    effect = "PreferNoSchedule"
+  }
+}
+`, nodeName, key, value)
+}
+
+func TestMergeNodeTaints(t *testing.T) {
+	added := metav1.Now()
+	current := []api.Taint{
+		{Key: "node.kubernetes.io/unreachable", Effect: api.TaintEffectNoExecute, TimeAdded: &added},
+		{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule},
+		{Key: "old", Value: "one", Effect: api.TaintEffectNoSchedule},
+		{Key: "maintenance", Value: "true", Effect: api.TaintEffectNoExecute, TimeAdded: &added},
+	}
+	oldOwned := []api.Taint{
+		{Key: "old", Value: "one", Effect: api.TaintEffectNoSchedule},
+		{Key: "maintenance", Value: "true", Effect: api.TaintEffectNoExecute},
+	}
+	newOwned := []api.Taint{
+		{Key: "maintenance", Value: "true", Effect: api.TaintEffectNoExecute},
+		{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectPreferNoSchedule},
+	}
+
+	taints := mergeNodeTaints(current, oldOwned, newOwned)
+	expected := []api.Taint{
+		{Key: "node.kubernetes.io/unreachable", Effect: api.TaintEffectNoExecute, TimeAdded: &added},
+		{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule},
+		{Key: "maintenance", Value: "true", Effect: api.TaintEffectNoExecute, TimeAdded: &added},
+		{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectPreferNoSchedule},
+	}
+	if len(taints) != len(expected) {
+		t.Fatalf("Unexpected taints, expected: %#v\ngiven: %#v", expected, taints)
+	}
+	for i := range expected {
+		if taints[i].ToString() != expected[i].ToString() || taints[i].TimeAdded != expected[i].TimeAdded {
+			t.Fatalf("Unexpected taint %d, expected: %#v\ngiven: %#v", i, expected[i], taints[i])
+		}
+	}
+}
@@ -182,18 +182,22 @@ func resourceKubernetesPersistentVolumeCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandPersistentVolumeSpec(d.Get("spec").([]interface{}))
+	volume, err := expandPersistentVolume(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	volume := api.PersistentVolume{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 
 	log.Printf("[INFO] Creating new persistent volume: %#v", volume)
-	out, err := conn.CoreV1().PersistentVolumes().Create(ctx, &volume, metav1.CreateOptions{})
+	out := volume
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().PersistentVolumes().Create(ctx, volume, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = volume
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -204,7 +208,7 @@ func resourceKubernetesPersistentVolumeCreate(ctx context.Context, d *schema.Res
 		Pending: []string{"Pending"},
 		Timeout: d.Timeout(schema.TimeoutCreate),
 		Refresh: func() (interface{}, string, error) {
-			out, err := conn.CoreV1().PersistentVolumes().Get(ctx, metadata.Name, metav1.GetOptions{})
+			out, err := conn.CoreV1().PersistentVolumes().Get(ctx, volume.Name, metav1.GetOptions{})
 			if err != nil {
 				log.Printf("[ERROR] Received error: %#v", err)
 				return out, "Error", err
@@ -226,6 +230,17 @@ func resourceKubernetesPersistentVolumeCreate(ctx context.Context, d *schema.Res
 	return resourceKubernetesPersistentVolumeRead(ctx, d, meta)
 }
 
+func expandPersistentVolume(d *schema.ResourceData) (*api.PersistentVolume, error) {
+	spec, err := expandPersistentVolumeSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.PersistentVolume{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesPersistentVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPersistentVolumeExists(ctx, d, meta)
 	if err != nil {
@@ -247,7 +262,7 @@ func resourceKubernetesPersistentVolumeRead(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received persistent volume: %#v", volume)
-	err = d.Set("metadata", flattenMetadata(volume.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(volume.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -260,12 +275,25 @@ func resourceKubernetesPersistentVolumeRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceKubernetesPersistentVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		volume, err := expandPersistentVolume(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return applyResourceUpdate(ctx, d, meta, volume, resourceKubernetesPersistentVolumeRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.CoreV1().PersistentVolumes().Get(ctx, d.Id(), metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("spec") {
 		specOps, err := patchPersistentVolumeSpec("/spec", "spec", d)
 		if err != nil {
@@ -297,7 +325,7 @@ func resourceKubernetesPersistentVolumeDelete(ctx context.Context, d *schema.Res
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting persistent volume: %#v", name)
-	err = conn.CoreV1().PersistentVolumes().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().PersistentVolumes().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
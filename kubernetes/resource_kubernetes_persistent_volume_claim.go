@@ -99,7 +99,16 @@ func resourceKubernetesPersistentVolumeClaimCreate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Creating new persistent volume claim: %#v", claim)
-	out, err := conn.CoreV1().PersistentVolumeClaims(claim.Namespace).Create(ctx, claim, metav1.CreateOptions{})
+	out := claim
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().PersistentVolumeClaims(claim.Namespace).Create(ctx, claim, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = claim
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -177,7 +186,7 @@ func resourceKubernetesPersistentVolumeClaimRead(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received persistent volume claim: %#v", claim)
-	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -190,6 +199,17 @@ func resourceKubernetesPersistentVolumeClaimRead(ctx context.Context, d *schema.
 }
 
 func resourceKubernetesPersistentVolumeClaimUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		claim, err := expandPersistentVolumeClaim(map[string]interface{}{
+			"metadata": d.Get("metadata"),
+			"spec":     d.Get("spec"),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return applyResourceUpdate(ctx, d, meta, claim, resourceKubernetesPersistentVolumeClaimRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -200,7 +220,12 @@ func resourceKubernetesPersistentVolumeClaimUpdate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	// spec.resources.requests is the only editable field in Spec.
 	if d.HasChange("spec.0.resources.0.requests") {
 		r := d.Get("spec.0.resources.0.requests").(map[string]interface{})
@@ -240,7 +265,7 @@ func resourceKubernetesPersistentVolumeClaimDelete(ctx context.Context, d *schem
 	}
 
 	log.Printf("[INFO] Deleting persistent volume claim: %#v", name)
-	err = conn.CoreV1().PersistentVolumeClaims(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().PersistentVolumeClaims(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
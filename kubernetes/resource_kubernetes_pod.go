@@ -2,18 +2,23 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 	"time"
 
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPod() *schema.Resource {
@@ -22,6 +27,7 @@ func resourceKubernetesPod() *schema.Resource {
 		ReadContext:   resourceKubernetesPodRead,
 		UpdateContext: resourceKubernetesPodUpdate,
 		DeleteContext: resourceKubernetesPodDelete,
+		CustomizeDiff: resourceKubernetesPodCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -31,19 +37,64 @@ func resourceKubernetesPod() *schema.Resource {
 				Type:    resourceKubernetesPodV0().CoreConfigSchema().ImpliedType(),
 				Upgrade: resourceKubernetesPodUpgradeV0,
 			},
+			{
+				Version: 1,
+				Type:    resourceKubernetesPodV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKubernetesPodUpgradeV1,
+			},
 		},
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
-		Schema: resourceKubernetesPodSchemaV1(),
+		Schema: resourceKubernetesPodSchemaV2(),
 	}
 }
 
-func resourceKubernetesPodSchemaV1() map[string]*schema.Schema {
+func resourceKubernetesPodSchemaV2() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"metadata": namespacedMetadataSchema("pod", true),
+		"ephemeral_container": {
+			Type:        schema.TypeList,
+			Description: "Ephemeral containers to run in the existing pod for debugging. They are added through the `ephemeralcontainers` subresource after the pod has been created. Ephemeral containers can only be appended: removing or changing an existing one replaces the pod.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: ephemeralContainerFields(),
+			},
+		},
+		"wait_for": {
+			Type:        schema.TypeList,
+			Description: "What to wait for after the pod is created. By default Terraform waits for the pod to be `Running`.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Type:         schema.TypeString,
+						Description:  "One of `none` (do not wait), `phase` (wait for one of `phases`), `ready` (wait for the `Ready` condition) or `containers_ready` (wait for every container to be ready).",
+						Optional:     true,
+						Default:      podWaitForPhase,
+						ValidateFunc: validation.StringInSlice([]string{podWaitForNone, podWaitForPhase, podWaitForReady, podWaitForContainersReady}, false),
+					},
+					"phases": {
+						Type:        schema.TypeSet,
+						Description: "Pod phases which complete the wait when `mode` is `phase`. Defaults to `Running`.",
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(api.PodPending),
+								string(api.PodRunning),
+								string(api.PodSucceeded),
+								string(api.PodFailed),
+								string(api.PodUnknown),
+							}, false),
+						},
+					},
+				},
+			},
+		},
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Specification of the desired behavior of the pod.",
@@ -62,19 +113,22 @@ func resourceKubernetesPodCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandPodSpec(d.Get("spec").([]interface{}))
+	pod, err := expandPod(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	pod := api.Pod{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
-
 	log.Printf("[INFO] Creating new pod: %#v", pod)
-	out, err := conn.CoreV1().Pods(metadata.Namespace).Create(ctx, &pod, metav1.CreateOptions{})
+	out := pod
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = pod
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -83,32 +137,44 @@ func resourceKubernetesPodCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(buildId(out.ObjectMeta))
 
-	stateConf := &resource.StateChangeConf{
-		Target:  []string{"Running"},
-		Pending: []string{"Pending"},
-		Timeout: d.Timeout(schema.TimeoutCreate),
-		Refresh: func() (interface{}, string, error) {
-			out, err := conn.CoreV1().Pods(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+	waitFor := expandPodWaitFor(d.Get("wait_for").([]interface{}))
+	if waitFor.mode != podWaitForNone {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			pod, err := conn.CoreV1().Pods(out.Namespace).Get(ctx, out.Name, metav1.GetOptions{})
 			if err != nil {
 				log.Printf("[ERROR] Received error: %#v", err)
-				return out, "Error", err
+				return resource.NonRetryableError(err)
 			}
+			log.Printf("[DEBUG] Pods %s status received: %#v", pod.Name, pod.Status.Phase)
 
-			statusPhase := fmt.Sprintf("%v", out.Status.Phase)
-			log.Printf("[DEBUG] Pods %s status received: %#v", out.Name, statusPhase)
-			return out, statusPhase, nil
-		},
-	}
-	_, err = stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		lastWarnings, wErr := getLastWarningsForObject(ctx, conn, out.ObjectMeta, "Pod", 3)
-		if wErr != nil {
-			return diag.FromErr(wErr)
+			done, err := podWaitConditionMet(pod, waitFor)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if !done {
+				return resource.RetryableError(fmt.Errorf("Waiting for pod %s (%s, phase %s)", pod.Name, waitFor.mode, pod.Status.Phase))
+			}
+			return nil
+		})
+		if err != nil {
+			dctx, cancel := diagnosticsContext(ctx)
+			defer cancel()
+			lastWarnings, wErr := getLastWarningsForObject(dctx, conn, out.ObjectMeta, "Pod", 3)
+			if wErr != nil {
+				return diag.FromErr(wErr)
+			}
+			return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
 		}
-		return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
 	}
 	log.Printf("[INFO] Pod %s created", out.Name)
 
+	if len(d.Get("ephemeral_container").([]interface{})) > 0 {
+		err = updatePodEphemeralContainers(ctx, conn, out.Namespace, out.Name, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceKubernetesPodRead(ctx, d, meta)
 }
 
@@ -123,29 +189,186 @@ func resourceKubernetesPodUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	if d.HasChange("spec") {
-		specOps, err := patchPodSpec("/spec", "spec.0.", d)
+	var out *api.Pod
+	if useServerSideApply(meta) {
+		out, err = expandPod(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = applyObject(ctx, meta, out)
+		if err != nil {
+			return diag.Errorf("Failed to update pod: %s", err)
+		}
+	} else {
+		ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+			return conn.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if d.HasChange("spec") {
+			specOps, err := patchPodSpec("/spec", "spec.0.", d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			ops = append(ops, specOps...)
+		}
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+
+		log.Printf("[INFO] Updating pod %s: %s", d.Id(), ops)
+
+		out, err = conn.CoreV1().Pods(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		ops = append(ops, specOps...)
 	}
-	data, err := ops.MarshalJSON()
+	log.Printf("[INFO] Submitted updated pod: %#v", out)
+
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.HasChange("ephemeral_container") {
+		err = updatePodEphemeralContainers(ctx, conn, namespace, name, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesPodRead(ctx, d, meta)
+}
+
+// updatePodEphemeralContainers appends the configured ephemeral containers which are
+// not yet part of the pod. The API does not allow existing ones to be changed or removed.
+func updatePodEphemeralContainers(ctx context.Context, conn *kubernetes.Clientset, namespace, name string, d *schema.ResourceData) error {
+	containers, err := expandEphemeralContainers(d.Get("ephemeral_container").([]interface{}))
 	if err != nil {
-		return diag.Errorf("Failed to marshal update operations: %s", err)
+		return err
 	}
 
-	log.Printf("[INFO] Updating pod %s: %s", d.Id(), ops)
+	patchPod, err := ephemeralContainersPatchPod(conn)
+	if err != nil {
+		return err
+	}
+	if !patchPod {
+		return updateLegacyEphemeralContainers(ctx, conn, namespace, name, containers)
+	}
 
-	out, err := conn.CoreV1().Pods(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	pod, err := conn.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return fmt.Errorf("Failed to read ephemeral containers of pod %s: %s", name, err)
+	}
+	added := missingEphemeralContainers(pod.Spec.EphemeralContainers, containers)
+	if len(added) == 0 {
+		return nil
+	}
+	// Ephemeral containers are merged by name, so the patch only lists the added ones
+	data, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"ephemeralContainers": added,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to marshal ephemeral containers: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated pod: %#v", out)
 
-	d.SetId(buildId(out.ObjectMeta))
-	return resourceKubernetesPodRead(ctx, d, meta)
+	log.Printf("[INFO] Adding ephemeral containers to pod %s: %s", name, string(data))
+	out, err := conn.CoreV1().Pods(namespace).Patch(ctx, name, pkgApi.StrategicMergePatchType, data, metav1.PatchOptions{}, "ephemeralcontainers")
+	if err != nil {
+		return fmt.Errorf("Failed to update ephemeral containers of pod %s: %s", name, err)
+	}
+	log.Printf("[INFO] Submitted updated ephemeral containers: %#v", out.Spec.EphemeralContainers)
+	return nil
+}
+
+// updateLegacyEphemeralContainers appends ephemeral containers through the EphemeralContainers
+// kind, which the ephemeralcontainers subresource served before Kubernetes 1.23.
+func updateLegacyEphemeralContainers(ctx context.Context, conn *kubernetes.Clientset, namespace, name string, containers []api.EphemeralContainer) error {
+	ec, err := conn.CoreV1().Pods(namespace).GetEphemeralContainers(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to read ephemeral containers of pod %s: %s", name, err)
+	}
+	added := missingEphemeralContainers(ec.EphemeralContainers, containers)
+	if len(added) == 0 {
+		return nil
+	}
+	ec.EphemeralContainers = append(ec.EphemeralContainers, added...)
+
+	log.Printf("[INFO] Updating ephemeral containers of pod %s: %#v", name, ec.EphemeralContainers)
+	out, err := conn.CoreV1().Pods(namespace).UpdateEphemeralContainers(ctx, name, ec, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to update ephemeral containers of pod %s: %s", name, err)
+	}
+	log.Printf("[INFO] Submitted updated ephemeral containers: %#v", out)
+	return nil
+}
+
+// missingEphemeralContainers returns the configured ephemeral containers which are not part of the pod.
+func missingEphemeralContainers(existing, configured []api.EphemeralContainer) []api.EphemeralContainer {
+	names := make(map[string]bool, len(existing))
+	for _, c := range existing {
+		names[c.Name] = true
+	}
+	added := make([]api.EphemeralContainer, 0, len(configured))
+	for _, c := range configured {
+		if !names[c.Name] {
+			added = append(added, c)
+		}
+	}
+	return added
+}
+
+// ephemeralContainersPatchPod reports whether the ephemeralcontainers subresource takes a Pod.
+// Before Kubernetes 1.23 it took the EphemeralContainers kind instead.
+func ephemeralContainersPatchPod(conn *kubernetes.Clientset) (bool, error) {
+	serverVersion, err := conn.ServerVersion()
+	if err != nil {
+		return false, err
+	}
+	k8sVersion, err := gversion.NewVersion(serverVersion.String())
+	if err != nil {
+		return false, err
+	}
+	return releaseAtLeast(k8sVersion, 1, 23), nil
+}
+
+// releaseAtLeast compares the release of the version only,
+// vendor builds like v1.23.0-gke.100 parse as pre-releases.
+func releaseAtLeast(v *gversion.Version, major, minor int) bool {
+	segments := v.Segments()
+	return segments[0] > major || segments[0] == major && segments[1] >= minor
+}
+
+// resourceKubernetesPodCustomizeDiff replaces the pod when an ephemeral container which
+// already runs in it is changed or removed, only new ones can be added in place.
+func resourceKubernetesPodCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("ephemeral_container") {
+		return nil
+	}
+	o, n := diff.GetChange("ephemeral_container")
+	oldContainers := o.([]interface{})
+	newContainers := n.([]interface{})
+	if len(newContainers) < len(oldContainers) {
+		return diff.ForceNew("ephemeral_container")
+	}
+	for i := range oldContainers {
+		if !reflect.DeepEqual(oldContainers[i], newContainers[i]) {
+			return diff.ForceNew("ephemeral_container")
+		}
+	}
+	return nil
+}
+
+func expandPod(d *schema.ResourceData) (*api.Pod, error) {
+	spec, err := expandPodSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.Pod{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
 }
 
 func resourceKubernetesPodRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -174,7 +397,7 @@ func resourceKubernetesPodRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	log.Printf("[INFO] Received pod: %#v", pod)
 
-	err = d.Set("metadata", flattenMetadata(pod.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(pod.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -188,6 +411,15 @@ func resourceKubernetesPodRead(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	ephemeralContainers, err := flattenEphemeralContainers(pod.Spec.EphemeralContainers, serviceAccountTokenRegex(pod.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("ephemeral_container", ephemeralContainers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 
 }
@@ -204,7 +436,7 @@ func resourceKubernetesPodDelete(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	log.Printf("[INFO] Deleting pod: %#v", name)
-	err = conn.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().Pods(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
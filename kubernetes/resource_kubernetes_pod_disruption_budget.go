@@ -74,25 +74,50 @@ func resourceKubernetesPodDisruptionBudget() *schema.Resource {
 	}
 }
 
+// podDisruptionBudgetClient uses policy/v1 when the cluster serves it, the PodDisruptionBudget
+// schema is the same in policy/v1beta1 but an empty selector selects all the pods in policy/v1.
+func podDisruptionBudgetClient(meta interface{}, namespace string) (*versionedResource, error) {
+	return newVersionedResource(meta, "poddisruptionbudgets", "PodDisruptionBudget", namespace, "policy/v1", "policy/v1beta1")
+}
+
 func resourceKubernetesPodDisruptionBudgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := podDisruptionBudgetClient(meta, namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if useServerSideApply(meta) {
+		pdb, err := expandPodDisruptionBudget(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = client.Apply(ctx, meta, pdb, &api.PodDisruptionBudget{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceKubernetesPodDisruptionBudgetRead(ctx, d, meta)
+	}
+
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		out := &api.PodDisruptionBudget{}
+		err := client.Get(ctx, name, out)
+		return out, err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 
 	log.Printf("[INFO] Updating pod disruption budget %s: %s", d.Id(), ops)
-	out, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out := &api.PodDisruptionBudget{}
+	err = client.Patch(ctx, name, pkgApi.JSONPatchType, data, out)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -104,23 +129,26 @@ func resourceKubernetesPodDisruptionBudgetUpdate(ctx context.Context, d *schema.
 }
 
 func resourceKubernetesPodDisruptionBudgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	pdb, err := expandPodDisruptionBudget(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandPodDisruptionBudgetSpec(d.Get("spec").([]interface{}))
+	client, err := podDisruptionBudgetClient(meta, pdb.Namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	pdb := api.PodDisruptionBudget{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 
 	log.Printf("[INFO] Creating new pod disruption budget: %#v", pdb)
-	out, err := conn.PolicyV1beta1().PodDisruptionBudgets(metadata.Namespace).Create(ctx, &pdb, metav1.CreateOptions{})
+	out := &api.PodDisruptionBudget{}
+	if useServerSideApply(meta) {
+		err = client.ApplyNew(ctx, d, meta, pdb, out)
+	} else {
+		err = client.Create(ctx, pdb, out)
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			err = client.Adopt(ctx, d, meta, pdb, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -131,6 +159,17 @@ func resourceKubernetesPodDisruptionBudgetCreate(ctx context.Context, d *schema.
 	return resourceKubernetesPodDisruptionBudgetRead(ctx, d, meta)
 }
 
+func expandPodDisruptionBudget(d *schema.ResourceData) (*api.PodDisruptionBudget, error) {
+	spec, err := expandPodDisruptionBudgetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.PodDisruptionBudget{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesPodDisruptionBudgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPodDisruptionBudgetExists(ctx, d, meta)
 	if err != nil {
@@ -139,25 +178,26 @@ func resourceKubernetesPodDisruptionBudgetRead(ctx context.Context, d *schema.Re
 	if !exists {
 		return diag.Diagnostics{}
 	}
-	conn, err := meta.(KubeClientsets).MainClientset()
+
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := podDisruptionBudgetClient(meta, namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Reading pod disruption budget %s", name)
-	pdb, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	pdb := &api.PodDisruptionBudget{}
+	err = client.Get(ctx, name, pdb)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Received pod disruption budget: %#v", pdb)
-	err = d.Set("metadata", flattenMetadata(pdb.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(pdb.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -171,18 +211,17 @@ func resourceKubernetesPodDisruptionBudgetRead(ctx context.Context, d *schema.Re
 }
 
 func resourceKubernetesPodDisruptionBudgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := podDisruptionBudgetClient(meta, namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting pod disruption budget %#v", name)
-	err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = client.Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return diag.FromErr(err)
@@ -195,18 +234,17 @@ func resourceKubernetesPodDisruptionBudgetDelete(ctx context.Context, d *schema.
 }
 
 func resourceKubernetesPodDisruptionBudgetExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return false, err
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := podDisruptionBudgetClient(meta, namespace)
 	if err != nil {
 		return false, err
 	}
 
 	log.Printf("[INFO] Checking pod disruption budget %s", name)
-	_, err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	err = client.Get(ctx, name, nil)
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "k8s.io/api/policy/v1beta1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestAccKubernetesPodDisruptionBudget_basic(t *testing.T) {
@@ -87,11 +86,6 @@ func TestAccKubernetesPodDisruptionBudget_basic(t *testing.T) {
 }
 
 func testAccCheckKubernetesPodDisruptionBudgetDestroy(s *terraform.State) error {
-	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-
-	if err != nil {
-		return err
-	}
 	ctx := context.TODO()
 
 	for _, rs := range s.RootModule().Resources {
@@ -104,7 +98,12 @@ func testAccCheckKubernetesPodDisruptionBudgetDestroy(s *terraform.State) error
 			return err
 		}
 
-		resp, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+		client, err := podDisruptionBudgetClient(testAccProvider.Meta(), namespace)
+		if err != nil {
+			return err
+		}
+		resp := &api.PodDisruptionBudget{}
+		err = client.Get(ctx, name, resp)
 		if err == nil {
 			if resp.Namespace == namespace && resp.Name == name {
 				return fmt.Errorf("Pod Disruption Budget still exists: %s", rs.Primary.ID)
@@ -122,24 +121,18 @@ func testAccCheckKubernetesPodDisruptionBudgetExists(n string, obj *api.PodDisru
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-		if err != nil {
-			return err
-		}
 		ctx := context.TODO()
 
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
-
-		out, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+		client, err := podDisruptionBudgetClient(testAccProvider.Meta(), namespace)
 		if err != nil {
 			return err
 		}
 
-		*obj = *out
-		return nil
+		return client.Get(ctx, name, obj)
 	}
 }
 
@@ -7,7 +7,7 @@ import (
 )
 
 func resourceKubernetesPodV0() *schema.Resource {
-	schemaV1 := resourceKubernetesPodSchemaV1()
+	schemaV1 := resourceKubernetesPodV1().Schema
 	schemaV0 := patchPodSpecWithResourcesFieldV0(schemaV1)
 	return &schema.Resource{Schema: schemaV0}
 }
@@ -15,3 +15,13 @@ func resourceKubernetesPodV0() *schema.Resource {
 func resourceKubernetesPodUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	return upgradePodSpecWithResourcesFieldV0(ctx, rawState, meta)
 }
+
+func resourceKubernetesPodV1() *schema.Resource {
+	schemaV2 := resourceKubernetesPodSchemaV2()
+	schemaV1 := patchPodSpecWithSchedulingFieldsV1(schemaV2)
+	return &schema.Resource{Schema: schemaV1}
+}
+
+func resourceKubernetesPodUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return upgradePodSpecWithSchedulingFieldsV1(ctx, rawState, meta)
+}
@@ -369,20 +369,22 @@ func resourceKubernetesPodSecurityPolicyCreate(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandPodSecurityPolicySpec(d.Get("spec").([]interface{}))
-
+	psp, err := expandPodSecurityPolicy(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	psp := &policy.PodSecurityPolicy{
-		ObjectMeta: metadata,
-		Spec:       spec,
-	}
-
 	log.Printf("[INFO] Creating new PodSecurityPolicy: %#v", psp)
-	out, err := conn.PolicyV1beta1().PodSecurityPolicies().Create(ctx, psp, metav1.CreateOptions{})
+	out := psp
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.PolicyV1beta1().PodSecurityPolicies().Create(ctx, psp, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = psp
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -393,6 +395,17 @@ func resourceKubernetesPodSecurityPolicyCreate(ctx context.Context, d *schema.Re
 	return resourceKubernetesPodSecurityPolicyRead(ctx, d, meta)
 }
 
+func expandPodSecurityPolicy(d *schema.ResourceData) (*policy.PodSecurityPolicy, error) {
+	spec, err := expandPodSecurityPolicySpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &policy.PodSecurityPolicy{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       spec,
+	}, nil
+}
+
 func resourceKubernetesPodSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPodSecurityPolicyExists(ctx, d, meta)
 	if err != nil {
@@ -416,7 +429,7 @@ func resourceKubernetesPodSecurityPolicyRead(ctx context.Context, d *schema.Reso
 	}
 
 	log.Printf("[INFO] Received PodSecurityPolicy: %#v", psp)
-	err = d.Set("metadata", flattenMetadata(psp.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(psp.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -432,6 +445,14 @@ func resourceKubernetesPodSecurityPolicyRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceKubernetesPodSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		psp, err := expandPodSecurityPolicy(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return applyResourceUpdate(ctx, d, meta, psp, resourceKubernetesPodSecurityPolicyRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -439,7 +460,12 @@ func resourceKubernetesPodSecurityPolicyUpdate(ctx context.Context, d *schema.Re
 
 	name := d.Id()
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.PolicyV1beta1().PodSecurityPolicies().Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	if d.HasChange("spec") {
 		diffOps, err := patchPodSecurityPolicySpec("spec.0.", "/spec", d)
@@ -472,7 +498,7 @@ func resourceKubernetesPodSecurityPolicyDelete(ctx context.Context, d *schema.Re
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting PodSecurityPolicy: %#v", name)
-	err = conn.PolicyV1beta1().PodSecurityPolicies().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.PolicyV1beta1().PodSecurityPolicies().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
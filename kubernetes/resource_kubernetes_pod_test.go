@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
+	gversion "github.com/hashicorp/go-version"
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -38,6 +40,43 @@ func TestAccKubernetesPod_minimal(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPod_waitForSucceeded(t *testing.T) {
+	var conf api.Pod
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigWaitForPhase(name, busyboxImageVersion, "Succeeded"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "wait_for.0.mode", "phase"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "wait_for.0.phases.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_waitForImagePullBackOff(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPodConfigWaitForPhase(name, "busybox:does-not-exist", "Running"),
+				ExpectError: regexp.MustCompile(`Container "containername" of pod .* is in ImagePullBackOff`),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPod_basic(t *testing.T) {
 	var conf1 api.Pod
 
@@ -285,6 +324,35 @@ func TestAccKubernetesPod_with_pod_security_context_run_as_group(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPod_with_pod_security_context_seccomp_profile(t *testing.T) {
+	var conf api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	imageName := nginxImageVersion
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.19.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigWithSecurityContextSeccompProfile(podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.security_context.0.fs_group_change_policy", "OnRootMismatch"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.security_context.0.seccomp_profile.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.security_context.0.seccomp_profile.0.type", "RuntimeDefault"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.security_context.0.seccomp_profile.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.security_context.0.seccomp_profile.0.type", "Unconfined"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPod_with_container_liveness_probe_using_exec(t *testing.T) {
 	var conf api.Pod
 
@@ -621,6 +689,72 @@ func TestAccKubernetesPod_with_empty_dir_volume_with_sizeLimit(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPod_with_ephemeral_volume(t *testing.T) {
+	var conf api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	imageName := nginxImageVersion
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.21.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigWithEphemeralVolume(podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.volume_mount.0.name", "scratch-volume"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.ephemeral.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.ephemeral.0.volume_claim_template.0.metadata.0.labels.type", "scratch"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.ephemeral.0.volume_claim_template.0.spec.0.access_modes.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.ephemeral.0.volume_claim_template.0.spec.0.resources.0.requests.storage", "1Gi"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_with_ephemeral_container(t *testing.T) {
+	var conf1, conf2 api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	imageName := nginxImageVersion
+	debugImageName := busyboxImageVersion
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfEphemeralContainersUnsupported(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigMinimal(podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf1),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "ephemeral_container.#", "0"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodConfigWithEphemeralContainer(podName, imageName, debugImageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf2),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "ephemeral_container.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "ephemeral_container.0.name", "debugger"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "ephemeral_container.0.image", debugImageName),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "ephemeral_container.0.target_container_name", "containername"),
+					testAccCheckKubernetesPodForceNew(&conf1, &conf2, false),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPod_with_secret_vol_items(t *testing.T) {
 	var conf api.Pod
 
@@ -1052,6 +1186,23 @@ func testAccCheckKubernetesPodExists(n string, obj *api.Pod) resource.TestCheckF
 	}
 }
 
+func skipIfEphemeralContainersUnsupported(t *testing.T) {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resources, err := conn.Discovery().ServerResourcesForGroupVersion("v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range resources.APIResources {
+		if r.Name == "pods/ephemeralcontainers" {
+			return
+		}
+	}
+	t.Skip("The EphemeralContainers feature gate must be enabled on the cluster for this test to run - skipping")
+}
+
 func testAccCheckKubernetesPodForceNew(old, new *api.Pod, wantNew bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if wantNew {
@@ -1254,6 +1405,66 @@ func testAccKubernetesPodConfigWithSecurityContext(podName, imageName string) st
 `, podName, imageName)
 }
 
+func testAccKubernetesPodConfigWithEphemeralContainer(podName, imageName, debugImageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image = "%s"
+      name  = "containername"
+    }
+  }
+
+  ephemeral_container {
+    image                 = "%s"
+    name                  = "debugger"
+    command               = ["sleep", "3600"]
+    stdin                 = true
+    tty                   = true
+    target_container_name = "containername"
+  }
+}
+`, podName, imageName, debugImageName)
+}
+
+func testAccKubernetesPodConfigWithSecurityContextSeccompProfile(podName, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    labels = {
+      app = "pod_label"
+    }
+
+    name = "%s"
+  }
+
+  spec {
+    security_context {
+      fs_group               = 100
+      fs_group_change_policy = "OnRootMismatch"
+
+      seccomp_profile {
+        type = "RuntimeDefault"
+      }
+    }
+
+    container {
+      image = "%s"
+      name  = "containername"
+
+      security_context {
+        seccomp_profile {
+          type = "Unconfined"
+        }
+      }
+    }
+  }
+}
+`, podName, imageName)
+}
+
 func testAccKubernetesPodConfigWithSecurityContextRunAsGroup(podName, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
   metadata {
@@ -1880,6 +2091,51 @@ func testAccKubernetesPodConfigWithEmptyDirVolumesSizeLimit(podName, imageName s
 `, podName, imageName)
 }
 
+func testAccKubernetesPodConfigWithEphemeralVolume(podName, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image = "%s"
+      name  = "containername"
+
+      volume_mount {
+        mount_path = "/scratch"
+        name       = "scratch-volume"
+      }
+    }
+
+    volume {
+      name = "scratch-volume"
+
+      ephemeral {
+        volume_claim_template {
+          metadata {
+            labels = {
+              type = "scratch"
+            }
+          }
+
+          spec {
+            access_modes = ["ReadWriteOnce"]
+
+            resources {
+              requests = {
+                storage = "1Gi"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, podName, imageName)
+}
+
 func testAccKubernetesPodConfigNodeSelector(podName, imageName, region string) string {
 	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
   metadata {
@@ -2208,6 +2464,27 @@ func testAccKubernetesPodConfigMinimal(name, imageName string) string {
 `, name, imageName)
 }
 
+func testAccKubernetesPodConfigWaitForPhase(name, imageName, phase string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+  wait_for {
+    mode   = "phase"
+    phases = ["%s"]
+  }
+  spec {
+    restart_policy = "Never"
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["true"]
+    }
+  }
+}
+`, name, phase, imageName)
+}
+
 func testAccKubernetesPodConfigEmptyBlocks(name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
   metadata {
@@ -2371,3 +2648,26 @@ func testAccKubernetesPodConfig_afterUpdate(name, imageName string) string {
 }
 `, name, imageName)
 }
+
+func TestReleaseAtLeast(t *testing.T) {
+	testCases := []struct {
+		Version  string
+		Expected bool
+	}{
+		{"v1.23.0", false},
+		{"v1.24.0", true},
+		{"v1.24.0-gke.100", true},
+		{"v1.24.0-rc.1", true},
+		{"v1.25.3+k3s1", true},
+		{"v2.0.0", true},
+	}
+	for _, tc := range testCases {
+		v, err := gversion.NewVersion(tc.Version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := releaseAtLeast(v, 1, 24); got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tc.Version, tc.Expected, got)
+		}
+	}
+}
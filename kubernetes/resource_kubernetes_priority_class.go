@@ -52,20 +52,19 @@ func resourceKubernetesPriorityClassCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	value := d.Get("value").(int)
-	description := d.Get("description").(string)
-	globalDefault := d.Get("global_default").(bool)
-
-	priorityClass := api.PriorityClass{
-		ObjectMeta:    metadata,
-		Description:   description,
-		GlobalDefault: globalDefault,
-		Value:         int32(value),
-	}
+	priorityClass := expandPriorityClass(d)
 
 	log.Printf("[INFO] Creating new priority class: %#v", priorityClass)
-	out, err := conn.SchedulingV1().PriorityClasses().Create(ctx, &priorityClass, metav1.CreateOptions{})
+	out := priorityClass
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.SchedulingV1().PriorityClasses().Create(ctx, priorityClass, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = priorityClass
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create priority class: %s", err)
 	}
@@ -75,6 +74,15 @@ func resourceKubernetesPriorityClassCreate(ctx context.Context, d *schema.Resour
 	return resourceKubernetesPriorityClassRead(ctx, d, meta)
 }
 
+func expandPriorityClass(d *schema.ResourceData) *api.PriorityClass {
+	return &api.PriorityClass{
+		ObjectMeta:    expandMetadata(d.Get("metadata").([]interface{})),
+		Description:   d.Get("description").(string),
+		GlobalDefault: d.Get("global_default").(bool),
+		Value:         int32(d.Get("value").(int)),
+	}
+}
+
 func resourceKubernetesPriorityClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPriorityClassExists(ctx, d, meta)
 	if err != nil {
@@ -98,7 +106,7 @@ func resourceKubernetesPriorityClassRead(ctx context.Context, d *schema.Resource
 	}
 	log.Printf("[INFO] Received priority class: %#v", priorityClass)
 
-	err = d.Set("metadata", flattenMetadata(priorityClass.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(priorityClass.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -122,6 +130,10 @@ func resourceKubernetesPriorityClassRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubernetesPriorityClassUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandPriorityClass(d), resourceKubernetesPriorityClassRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -129,7 +141,12 @@ func resourceKubernetesPriorityClassUpdate(ctx context.Context, d *schema.Resour
 
 	name := d.Id()
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.SchedulingV1().PriorityClasses().Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	if d.HasChange("description") {
 		description := d.Get("description").(string)
@@ -171,7 +188,7 @@ func resourceKubernetesPriorityClassDelete(ctx context.Context, d *schema.Resour
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting priority class: %#v", name)
-	err = conn.SchedulingV1().PriorityClasses().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.SchedulingV1().PriorityClasses().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -38,13 +38,18 @@ func resourceKubernetesReplicationController() *schema.Resource {
 				Type:    resourceKubernetesReplicationControllerV0().CoreConfigSchema().ImpliedType(),
 				Upgrade: resourceKubernetesReplicationControllerUpgradeV0,
 			},
+			{
+				Version: 1,
+				Type:    resourceKubernetesReplicationControllerV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKubernetesReplicationControllerUpgradeV1,
+			},
 		},
-		SchemaVersion: 1,
-		Schema:        resourceKubernetesReplicationControllerSchemaV1(),
+		SchemaVersion: 2,
+		Schema:        resourceKubernetesReplicationControllerSchemaV2(),
 	}
 }
 
-func resourceKubernetesReplicationControllerSchemaV1() map[string]*schema.Schema {
+func resourceKubernetesReplicationControllerSchemaV2() map[string]*schema.Schema {
 
 	return map[string]*schema.Schema{
 		"metadata": namespacedMetadataSchema("replication controller", true),
@@ -112,20 +117,22 @@ func resourceKubernetesReplicationControllerCreate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-
-	spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
+	rc, err := expandReplicationController(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	rc := api.ReplicationController{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
-
 	log.Printf("[INFO] Creating new replication controller: %#v", rc)
-	out, err := conn.CoreV1().ReplicationControllers(metadata.Namespace).Create(ctx, &rc, metav1.CreateOptions{})
+	out := rc
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().ReplicationControllers(rc.Namespace).Create(ctx, rc, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = rc
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create replication controller: %s", err)
 	}
@@ -149,6 +156,17 @@ func resourceKubernetesReplicationControllerCreate(ctx context.Context, d *schem
 	return resourceKubernetesReplicationControllerRead(ctx, d, meta)
 }
 
+func expandReplicationController(d *schema.ResourceData) (*api.ReplicationController, error) {
+	spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.ReplicationController{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesReplicationControllerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesReplicationControllerExists(ctx, d, meta)
 	if err != nil {
@@ -175,12 +193,12 @@ func resourceKubernetesReplicationControllerRead(ctx context.Context, d *schema.
 	}
 	log.Printf("[INFO] Received replication controller: %#v", rc)
 
-	err = d.Set("metadata", flattenMetadata(rc.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(rc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	spec, err := flattenReplicationControllerSpec(rc.Spec, d)
+	spec, err := flattenReplicationControllerSpec(rc.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -204,27 +222,44 @@ func resourceKubernetesReplicationControllerUpdate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-
-	if d.HasChange("spec") {
-		spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
+	var out *api.ReplicationController
+	if useServerSideApply(meta) {
+		out, err = expandReplicationController(d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-
-		ops = append(ops, &ReplaceOperation{
-			Path:  "/spec",
-			Value: spec,
+		err = applyObject(ctx, meta, out)
+		if err != nil {
+			return diag.Errorf("Failed to update replication controller: %s", err)
+		}
+	} else {
+		ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+			return conn.CoreV1().ReplicationControllers(namespace).Get(ctx, name, metav1.GetOptions{})
 		})
-	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return diag.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating replication controller %q: %v", name, string(data))
-	out, err := conn.CoreV1().ReplicationControllers(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to update replication controller: %s", err)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if d.HasChange("spec") {
+			spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/spec",
+				Value: spec,
+			})
+		}
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating replication controller %q: %v", name, string(data))
+		out, err = conn.CoreV1().ReplicationControllers(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update replication controller: %s", err)
+		}
 	}
 	log.Printf("[INFO] Submitted updated replication controller: %#v", out)
 
@@ -272,7 +307,7 @@ func resourceKubernetesReplicationControllerDelete(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	err = conn.CoreV1().ReplicationControllers(namespace).Delete(ctx, name, deleteOptions)
+	err = conn.CoreV1().ReplicationControllers(namespace).Delete(ctx, name, expandDeleteOptions(d, deleteOptions))
 	if err != nil {
 		return diag.FromErr(err)
 	}
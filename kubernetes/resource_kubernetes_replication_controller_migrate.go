@@ -7,7 +7,7 @@ import (
 )
 
 func resourceKubernetesReplicationControllerV0() *schema.Resource {
-	schemaV1 := resourceKubernetesReplicationControllerSchemaV1()
+	schemaV1 := resourceKubernetesReplicationControllerV1().Schema
 	schemaV0 := patchTemplatePodSpecWithResourcesFieldV0(schemaV1)
 	return &schema.Resource{Schema: schemaV0}
 }
@@ -15,3 +15,13 @@ func resourceKubernetesReplicationControllerV0() *schema.Resource {
 func resourceKubernetesReplicationControllerUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	return upgradeTemplatePodSpecWithResourcesFieldV0(ctx, rawState, meta)
 }
+
+func resourceKubernetesReplicationControllerV1() *schema.Resource {
+	schemaV2 := resourceKubernetesReplicationControllerSchemaV2()
+	schemaV1 := patchTemplatePodSpecWithSchedulingFieldsV1(schemaV2)
+	return &schema.Resource{Schema: schemaV1}
+}
+
+func resourceKubernetesReplicationControllerUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return upgradeTemplatePodSpecWithSchedulingFieldsV1(ctx, rawState, meta)
+}
@@ -67,17 +67,22 @@ func resourceKubernetesResourceQuotaCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandResourceQuotaSpec(d.Get("spec").([]interface{}))
+	resQuota, err := expandResourceQuota(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	resQuota := api.ResourceQuota{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
+	spec := resQuota.Spec
 	log.Printf("[INFO] Creating new resource quota: %#v", resQuota)
-	out, err := conn.CoreV1().ResourceQuotas(metadata.Namespace).Create(ctx, &resQuota, metav1.CreateOptions{})
+	out := resQuota
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().ResourceQuotas(resQuota.Namespace).Create(ctx, resQuota, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = resQuota
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create resource quota: %s", err)
 	}
@@ -103,6 +108,17 @@ func resourceKubernetesResourceQuotaCreate(ctx context.Context, d *schema.Resour
 	return resourceKubernetesResourceQuotaRead(ctx, d, meta)
 }
 
+func expandResourceQuota(d *schema.ResourceData) (*api.ResourceQuota, error) {
+	spec, err := expandResourceQuotaSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.ResourceQuota{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesResourceQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesResourceQuotaExists(ctx, d, meta)
 	if err != nil {
@@ -137,7 +153,7 @@ func resourceKubernetesResourceQuotaRead(ctx context.Context, d *schema.Resource
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(resQuota.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(resQuota.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -160,7 +176,6 @@ func resourceKubernetesResourceQuotaUpdate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
 	var spec *api.ResourceQuotaSpec
 	waitForChangedSpec := false
 	if d.HasChange("spec") {
@@ -168,23 +183,44 @@ func resourceKubernetesResourceQuotaUpdate(ctx context.Context, d *schema.Resour
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		ops = append(ops, &ReplaceOperation{
-			Path:  "/spec",
-			Value: *spec,
-		})
 		waitForChangedSpec = true
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return diag.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating resource quota %q: %v", name, string(data))
-	out, err := conn.CoreV1().ResourceQuotas(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to update resource quota: %s", err)
+
+	if useServerSideApply(meta) {
+		resQuota, err := expandResourceQuota(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = applyObject(ctx, meta, resQuota)
+		if err != nil {
+			return diag.Errorf("Failed to update resource quota: %s", err)
+		}
+		log.Printf("[INFO] Submitted updated resource quota: %#v", resQuota)
+	} else {
+		ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+			return conn.CoreV1().ResourceQuotas(namespace).Get(ctx, name, metav1.GetOptions{})
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if spec != nil {
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/spec",
+				Value: *spec,
+			})
+		}
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating resource quota %q: %v", name, string(data))
+		out, err := conn.CoreV1().ResourceQuotas(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update resource quota: %s", err)
+		}
+		log.Printf("[INFO] Submitted updated resource quota: %#v", out)
+		d.SetId(buildId(out.ObjectMeta))
 	}
-	log.Printf("[INFO] Submitted updated resource quota: %#v", out)
-	d.SetId(buildId(out.ObjectMeta))
 
 	if waitForChangedSpec {
 		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
@@ -219,7 +255,7 @@ func resourceKubernetesResourceQuotaDelete(ctx context.Context, d *schema.Resour
 	}
 
 	log.Printf("[INFO] Deleting resource quota: %#v", name)
-	err = conn.CoreV1().ResourceQuotas(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().ResourceQuotas(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
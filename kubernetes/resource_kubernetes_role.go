@@ -71,15 +71,18 @@ func resourceKubernetesRoleCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	rules := expandRules(d.Get("rule").([]interface{}))
-
-	role := v1.Role{
-		ObjectMeta: metadata,
-		Rules:      *rules,
-	}
+	role := expandRole(d)
 	log.Printf("[INFO] Creating new role: %#v", role)
-	out, err := conn.RbacV1().Roles(metadata.Namespace).Create(ctx, &role, metav1.CreateOptions{})
+	out := role
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.RbacV1().Roles(role.Namespace).Create(ctx, role, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = role
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -90,6 +93,13 @@ func resourceKubernetesRoleCreate(ctx context.Context, d *schema.ResourceData, m
 	return resourceKubernetesRoleRead(ctx, d, meta)
 }
 
+func expandRole(d *schema.ResourceData) *v1.Role {
+	return &v1.Role{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Rules:      *expandRules(d.Get("rule").([]interface{})),
+	}
+}
+
 func resourceKubernetesRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesRoleExists(ctx, d, meta)
 	if err != nil {
@@ -116,7 +126,7 @@ func resourceKubernetesRoleRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	log.Printf("[INFO] Received role: %#v", role)
-	err = d.Set("metadata", flattenMetadata(role.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(role.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -130,6 +140,10 @@ func resourceKubernetesRoleRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceKubernetesRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandRole(d), resourceKubernetesRoleRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -140,7 +154,12 @@ func resourceKubernetesRoleUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.RbacV1().Roles(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("rule") {
 		rules := expandRules(d.Get("rule").([]interface{}))
 
@@ -177,7 +196,7 @@ func resourceKubernetesRoleDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	log.Printf("[INFO] Deleting role: %#v", name)
-	err = conn.RbacV1().Roles(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.RbacV1().Roles(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -53,14 +53,18 @@ func resourceKubernetesRoleBindingCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	binding := &api.RoleBinding{
-		ObjectMeta: metadata,
-		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
-		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
-	}
+	binding := expandRoleBinding(d)
 	log.Printf("[INFO] Creating new RoleBinding: %#v", binding)
-	out, err := conn.RbacV1().RoleBindings(metadata.Namespace).Create(ctx, binding, metav1.CreateOptions{})
+	out := binding
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.RbacV1().RoleBindings(binding.Namespace).Create(ctx, binding, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = binding
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -71,6 +75,14 @@ func resourceKubernetesRoleBindingCreate(ctx context.Context, d *schema.Resource
 	return resourceKubernetesRoleBindingRead(ctx, d, meta)
 }
 
+func expandRoleBinding(d *schema.ResourceData) *api.RoleBinding {
+	return &api.RoleBinding{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
+		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
+	}
+}
+
 func resourceKubernetesRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesRoleBindingExists(ctx, d, meta)
 	if err != nil {
@@ -97,7 +109,7 @@ func resourceKubernetesRoleBindingRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("[INFO] Received RoleBinding: %#v", binding)
-	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -120,6 +132,10 @@ func resourceKubernetesRoleBindingRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceKubernetesRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandRoleBinding(d), resourceKubernetesRoleBindingRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -130,7 +146,12 @@ func resourceKubernetesRoleBindingUpdate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.RbacV1().RoleBindings(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("subject") {
 		diffOps := patchRbacSubject(d)
 		ops = append(ops, diffOps...)
@@ -162,7 +183,7 @@ func resourceKubernetesRoleBindingDelete(ctx context.Context, d *schema.Resource
 	}
 
 	log.Printf("[INFO] Deleting RoleBinding: %#v", name)
-	err = conn.RbacV1().RoleBindings(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.RbacV1().RoleBindings(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,251 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	node "k8s.io/api/node/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesRuntimeClass() *schema.Resource {
+	docRuntimeClass := node.RuntimeClass{}.SwaggerDoc()
+	docScheduling := node.Scheduling{}.SwaggerDoc()
+
+	return &schema.Resource{
+		CreateContext: resourceKubernetesRuntimeClassCreate,
+		ReadContext:   resourceKubernetesRuntimeClassRead,
+		UpdateContext: resourceKubernetesRuntimeClassUpdate,
+		DeleteContext: resourceKubernetesRuntimeClassDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("runtime class", true),
+			"handler": {
+				Type:        schema.TypeString,
+				Description: docRuntimeClass["handler"],
+				Required:    true,
+				ForceNew:    true,
+			},
+			"overhead": {
+				Type:        schema.TypeList,
+				Description: "Overhead represents the resource overhead associated with running a pod for a given RuntimeClass.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pod_fixed": {
+							Type:         schema.TypeMap,
+							Description:  node.Overhead{}.SwaggerDoc()["podFixed"],
+							Required:     true,
+							ValidateFunc: validateResourceList,
+						},
+					},
+				},
+			},
+			"scheduling": {
+				Type:        schema.TypeList,
+				Description: docRuntimeClass["scheduling"],
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_selector": {
+							Type:        schema.TypeMap,
+							Description: docScheduling["nodeSelector"],
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"toleration": {
+							Type:        schema.TypeList,
+							Description: docScheduling["tolerations"],
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: tolerationFields(true),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// runtimeClassClient uses node.k8s.io/v1 when the cluster serves it, the RuntimeClass
+// schema is the same in node.k8s.io/v1beta1
+func runtimeClassClient(meta interface{}) (*versionedResource, error) {
+	return newVersionedResource(meta, "runtimeclasses", "RuntimeClass", "",
+		"node.k8s.io/v1", "node.k8s.io/v1beta1")
+}
+
+func resourceKubernetesRuntimeClassCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := runtimeClassClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	runtimeClass, err := expandRuntimeClass(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new runtime class: %#v", runtimeClass)
+	out := &node.RuntimeClass{}
+	if useServerSideApply(meta) {
+		err = client.ApplyNew(ctx, d, meta, runtimeClass, out)
+	} else {
+		err = client.Create(ctx, runtimeClass, out)
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			err = client.Adopt(ctx, d, meta, runtimeClass, out)
+		}
+	}
+	if err != nil {
+		return diag.Errorf("Failed to create runtime class: %s", err)
+	}
+	log.Printf("[INFO] Submitted new runtime class: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesRuntimeClassRead(ctx, d, meta)
+}
+
+func expandRuntimeClass(d *schema.ResourceData) (*node.RuntimeClass, error) {
+	runtimeClass := &node.RuntimeClass{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Handler:    d.Get("handler").(string),
+	}
+	var err error
+	runtimeClass.Overhead, err = expandRuntimeClassOverhead(d.Get("overhead").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	runtimeClass.Scheduling, err = expandRuntimeClassScheduling(d.Get("scheduling").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return runtimeClass, nil
+}
+
+func resourceKubernetesRuntimeClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := runtimeClassClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Reading runtime class %s", name)
+	runtimeClass := &node.RuntimeClass{}
+	err = client.Get(ctx, name, runtimeClass)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[INFO] Runtime class %s not found, removing from state", name)
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received runtime class: %#v", runtimeClass)
+
+	err = d.Set("metadata", flattenMetadata(runtimeClass.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("handler", runtimeClass.Handler)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("overhead", flattenRuntimeClassOverhead(runtimeClass.Overhead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("scheduling", flattenRuntimeClassScheduling(runtimeClass.Scheduling))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesRuntimeClassUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := runtimeClassClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if useServerSideApply(meta) {
+		runtimeClass, err := expandRuntimeClass(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = client.Apply(ctx, meta, runtimeClass, &node.RuntimeClass{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceKubernetesRuntimeClassRead(ctx, d, meta)
+	}
+
+	name := d.Id()
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		out := &node.RuntimeClass{}
+		err := client.Get(ctx, name, out)
+		return out, err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("overhead") {
+		overhead, err := expandRuntimeClassOverhead(d.Get("overhead").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ops = append(ops, patchRuntimeClassField("/overhead", overhead, overhead == nil))
+	}
+	if d.HasChange("scheduling") {
+		scheduling, err := expandRuntimeClassScheduling(d.Get("scheduling").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ops = append(ops, patchRuntimeClassField("/scheduling", scheduling, scheduling == nil))
+	}
+
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating runtime class %q: %v", name, string(data))
+	out := &node.RuntimeClass{}
+	err = client.Patch(ctx, name, pkgApi.JSONPatchType, data, out)
+	if err != nil {
+		return diag.Errorf("Failed to update runtime class: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated runtime class: %#v", out)
+
+	return resourceKubernetesRuntimeClassRead(ctx, d, meta)
+}
+
+func resourceKubernetesRuntimeClassDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := runtimeClassClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting runtime class: %#v", name)
+	err = client.Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
+	if err != nil && !errors.IsNotFound(err) {
+		return diag.Errorf("Failed to delete runtime class: %s", err)
+	}
+	log.Printf("[INFO] Runtime class %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,170 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	node "k8s.io/api/node/v1beta1"
+)
+
+func TestAccKubernetesRuntimeClass_basic(t *testing.T) {
+	var conf node.RuntimeClass
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_runtime_class.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesRuntimeClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesRuntimeClassConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRuntimeClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "handler", "runc"),
+					resource.TestCheckResourceAttr(resourceName, "overhead.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesRuntimeClassConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRuntimeClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr(resourceName, "handler", "runc"),
+					resource.TestCheckResourceAttr(resourceName, "overhead.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "overhead.0.pod_fixed.cpu", "250m"),
+					resource.TestCheckResourceAttr(resourceName, "overhead.0.pod_fixed.memory", "120Mi"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.node_selector.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.node_selector.runtime", "runc"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.0.key", "runtime"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.0.operator", "Equal"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.0.value", "runc"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.0.effect", "NoSchedule"),
+				),
+			},
+			{
+				Config: testAccKubernetesRuntimeClassConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRuntimeClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "overhead.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesRuntimeClassDestroy(s *terraform.State) error {
+	client, err := runtimeClassClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_runtime_class" {
+			continue
+		}
+
+		name := rs.Primary.ID
+
+		resp := &node.RuntimeClass{}
+		err := client.Get(ctx, name, resp)
+		if err == nil {
+			if resp.Name == name {
+				return fmt.Errorf("Runtime class still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesRuntimeClassExists(n string, obj *node.RuntimeClass) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, err := runtimeClassClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		out := &node.RuntimeClass{}
+		err = client.Get(ctx, rs.Primary.ID, out)
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesRuntimeClassConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_runtime_class" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  handler = "runc"
+}
+`, name)
+}
+
+func testAccKubernetesRuntimeClassConfig_modified(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_runtime_class" "test" {
+  metadata {
+    labels = {
+      TestLabelOne = "one"
+    }
+
+    name = "%s"
+  }
+
+  handler = "runc"
+
+  overhead {
+    pod_fixed = {
+      cpu    = "250m"
+      memory = "120Mi"
+    }
+  }
+
+  scheduling {
+    node_selector = {
+      runtime = "runc"
+    }
+
+    toleration {
+      key      = "runtime"
+      operator = "Equal"
+      value    = "runc"
+      effect   = "NoSchedule"
+    }
+  }
+}
+`, name)
+}
@@ -47,18 +47,19 @@ func resourceKubernetesSecretCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	secret := api.Secret{
-		ObjectMeta: metadata,
-		Data:       expandStringMapToByteMap(d.Get("data").(map[string]interface{})),
-	}
-
-	if v, ok := d.GetOk("type"); ok {
-		secret.Type = api.SecretType(v.(string))
-	}
+	secret := expandSecret(d)
 
 	log.Printf("[INFO] Creating new secret: %#v", secret)
-	out, err := conn.CoreV1().Secrets(metadata.Namespace).Create(ctx, &secret, metav1.CreateOptions{})
+	out := secret
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = secret
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -69,6 +70,18 @@ func resourceKubernetesSecretCreate(ctx context.Context, d *schema.ResourceData,
 	return resourceKubernetesSecretRead(ctx, d, meta)
 }
 
+func expandSecret(d *schema.ResourceData) *api.Secret {
+	secret := &api.Secret{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Data:       expandStringMapToByteMap(d.Get("data").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		secret.Type = api.SecretType(v.(string))
+	}
+	return secret
+}
+
 func resourceKubernetesSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesSecretExists(ctx, d, meta)
 	if err != nil {
@@ -94,7 +107,7 @@ func resourceKubernetesSecretRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	log.Printf("[INFO] Received secret: %#v", secret)
-	err = d.Set("metadata", flattenMetadata(secret.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(secret.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -106,6 +119,10 @@ func resourceKubernetesSecretRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceKubernetesSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandSecret(d), resourceKubernetesSecretRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -116,7 +133,12 @@ func resourceKubernetesSecretUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("data") {
 		oldV, newV := d.GetChange("data")
 
@@ -157,7 +179,7 @@ func resourceKubernetesSecretDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[INFO] Deleting secret: %q", name)
-	err = conn.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().Secrets(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -223,13 +223,18 @@ func resourceKubernetesServiceCreate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	svc := api.Service{
-		ObjectMeta: metadata,
-		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
-	}
+	svc := expandService(d)
 	log.Printf("[INFO] Creating new service: %#v", svc)
-	out, err := conn.CoreV1().Services(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
+	out := svc
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().Services(svc.Namespace).Create(ctx, svc, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = svc
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -268,6 +273,13 @@ func resourceKubernetesServiceCreate(ctx context.Context, d *schema.ResourceData
 	return resourceKubernetesServiceRead(ctx, d, meta)
 }
 
+func expandService(d *schema.ResourceData) *api.Service {
+	return &api.Service{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
+	}
+}
+
 func resourceKubernetesServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesServiceExists(ctx, d, meta)
 	if err != nil {
@@ -293,7 +305,7 @@ func resourceKubernetesServiceRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received service: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -318,6 +330,10 @@ func resourceKubernetesServiceRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceKubernetesServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandService(d), resourceKubernetesServiceRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -328,7 +344,12 @@ func resourceKubernetesServiceUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("spec") {
 		serverVersion, err := conn.ServerVersion()
 		if err != nil {
@@ -367,7 +388,7 @@ func resourceKubernetesServiceDelete(ctx context.Context, d *schema.ResourceData
 	}
 
 	log.Printf("[INFO] Deleting service: %#v", name)
-	err = conn.CoreV1().Services(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().Services(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
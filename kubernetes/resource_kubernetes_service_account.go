@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -69,8 +70,9 @@ func resourceKubernetesServiceAccount() *schema.Resource {
 				Default:     true,
 			},
 			"default_secret_name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Description: "Name of the token secret created for the service account by the token controller. Kubernetes 1.24 and later do not create it, in which case this is empty. Use `kubernetes_service_account_token` to obtain a token instead.",
+				Computed:    true,
 			},
 		},
 	}
@@ -82,22 +84,25 @@ func resourceKubernetesServiceAccountCreate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	svcAcc := api.ServiceAccount{
-		AutomountServiceAccountToken: ptrToBool(d.Get("automount_service_account_token").(bool)),
-		ObjectMeta:                   metadata,
-		ImagePullSecrets:             expandLocalObjectReferenceArray(d.Get("image_pull_secret").(*schema.Set).List()),
-		Secrets:                      expandServiceAccountSecrets(d.Get("secret").(*schema.Set).List(), ""),
-	}
+	svcAcc := expandServiceAccount(d, "")
 	log.Printf("[INFO] Creating new service account: %#v", svcAcc)
-	out, err := conn.CoreV1().ServiceAccounts(metadata.Namespace).Create(ctx, &svcAcc, metav1.CreateOptions{})
+	out := svcAcc
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.CoreV1().ServiceAccounts(svcAcc.Namespace).Create(ctx, svcAcc, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = svcAcc
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Submitted new service account: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
-	secret, err := getServiceAccountDefaultSecret(ctx, out.Name, svcAcc, d.Timeout(schema.TimeoutCreate), conn)
+	secret, err := getServiceAccountDefaultSecret(ctx, out.Name, *svcAcc, d.Timeout(schema.TimeoutCreate), conn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -109,9 +114,34 @@ func resourceKubernetesServiceAccountCreate(ctx context.Context, d *schema.Resou
 	return resourceKubernetesServiceAccountRead(ctx, d, meta)
 }
 
+// serviceAccountTokenSecretsCreated reports whether the cluster still generates a token
+// secret for every service account. The token controller stopped doing so in 1.24.
+func serviceAccountTokenSecretsCreated(conn *kubernetes.Clientset) (bool, error) {
+	serverVersion, err := conn.ServerVersion()
+	if err != nil {
+		return false, err
+	}
+	k8sVersion, err := gversion.NewVersion(serverVersion.String())
+	if err != nil {
+		return false, err
+	}
+	return !releaseAtLeast(k8sVersion, 1, 24), nil
+}
+
+// getServiceAccountDefaultSecret waits for the token secret generated for the service account.
+// An empty secret is returned without waiting on clusters which no longer generate it.
 func getServiceAccountDefaultSecret(ctx context.Context, name string, config api.ServiceAccount, timeout time.Duration, conn *kubernetes.Clientset) (*api.Secret, error) {
+	created, err := serviceAccountTokenSecretsCreated(conn)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		log.Printf("[DEBUG] Cluster does not generate token secrets, not waiting for one for service account %q", name)
+		return &api.Secret{}, nil
+	}
+
 	var svcAccTokens []api.Secret
-	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		resp, err := conn.CoreV1().ServiceAccounts(config.Namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -165,6 +195,15 @@ func findDefaultServiceAccount(ctx context.Context, sa *api.ServiceAccount, conn
 	   See this for where the default token is created in Kubernetes
 	   https://github.com/kubernetes/kubernetes/blob/release-1.13/pkg/controller/serviceaccount/tokens_controller.go#L384
 	*/
+	created, err := serviceAccountTokenSecretsCreated(conn)
+	if err != nil {
+		return "", err
+	}
+	if !created {
+		log.Printf("[DEBUG] Cluster does not generate token secrets, service account %q has no default one", sa.Name)
+		return "", nil
+	}
+
 	for _, saSecret := range sa.Secrets {
 		if !strings.HasPrefix(saSecret.Name, fmt.Sprintf("%s-token-", sa.Name)) {
 			log.Printf("[DEBUG] Skipping %s as it doesn't have the right name", saSecret.Name)
@@ -216,6 +255,15 @@ func diffObjectReferences(origOrs []api.ObjectReference, ors []api.ObjectReferen
 	return diff
 }
 
+func expandServiceAccount(d *schema.ResourceData, defaultSecretName string) *api.ServiceAccount {
+	return &api.ServiceAccount{
+		AutomountServiceAccountToken: ptrToBool(d.Get("automount_service_account_token").(bool)),
+		ObjectMeta:                   expandMetadata(d.Get("metadata").([]interface{})),
+		ImagePullSecrets:             expandLocalObjectReferenceArray(d.Get("image_pull_secret").(*schema.Set).List()),
+		Secrets:                      expandServiceAccountSecrets(d.Get("secret").(*schema.Set).List(), defaultSecretName),
+	}
+}
+
 func resourceKubernetesServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesServiceAccountExists(ctx, d, meta)
 	if err != nil {
@@ -241,7 +289,7 @@ func resourceKubernetesServiceAccountRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received service account: %#v", svcAcc)
-	err = d.Set("metadata", flattenMetadata(svcAcc.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(svcAcc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -275,6 +323,11 @@ func resourceKubernetesServiceAccountRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKubernetesServiceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		svcAcc := expandServiceAccount(d, d.Get("default_secret_name").(string))
+		return applyResourceUpdate(ctx, d, meta, svcAcc, resourceKubernetesServiceAccountRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -285,7 +338,12 @@ func resourceKubernetesServiceAccountUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("image_pull_secret") {
 		v := d.Get("image_pull_secret").(*schema.Set).List()
 		ops = append(ops, &ReplaceOperation{
@@ -336,7 +394,7 @@ func resourceKubernetesServiceAccountDelete(ctx context.Context, d *schema.Resou
 	}
 
 	log.Printf("[INFO] Deleting service account: %#v", name)
-	err = conn.CoreV1().ServiceAccounts(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().ServiceAccounts(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,186 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	authv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func resourceKubernetesServiceAccountToken() *schema.Resource {
+	docSpec := authv1.TokenRequestSpec{}.SwaggerDoc()
+
+	return &schema.Resource{
+		CreateContext: resourceKubernetesServiceAccountTokenCreate,
+		ReadContext:   resourceKubernetesServiceAccountTokenRead,
+		UpdateContext: resourceKubernetesServiceAccountTokenUpdate,
+		DeleteContext: resourceKubernetesServiceAccountTokenDelete,
+		CustomizeDiff: resourceKubernetesServiceAccountTokenCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "Namespace of the service account.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "default",
+			},
+			"service_account_name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the service account to request a token for.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"audiences": {
+				Type:        schema.TypeList,
+				Description: docSpec["audiences"],
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expiration_seconds": {
+				Type:         schema.TypeInt,
+				Description:  "Requested duration of validity of the token in seconds. The token issuer may return a token with a different validity duration, see `expiration_timestamp`. Must be at least 600.",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntAtLeast(600),
+			},
+			"rotation_threshold_seconds": {
+				Type:         schema.TypeInt,
+				Description:  "A new token is planned once the remaining lifetime of the current one drops below this many seconds.",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"expiration_timestamp": {
+				Type:        schema.TypeString,
+				Description: "Time at which the token expires, in RFC3339 format.",
+				Computed:    true,
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Description: "The bound service account token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace := d.Get("namespace").(string)
+	name := d.Get("service_account_name").(string)
+	req := authv1.TokenRequest{
+		Spec: authv1.TokenRequestSpec{
+			Audiences:         expandStringSlice(d.Get("audiences").([]interface{})),
+			ExpirationSeconds: ptrToInt64(int64(d.Get("expiration_seconds").(int))),
+		},
+	}
+
+	log.Printf("[INFO] Requesting token for service account %s/%s: %#v", namespace, name, req.Spec)
+	out, err := conn.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, name, &req, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to request token for service account %s/%s: %s", namespace, name, err)
+	}
+	log.Printf("[INFO] Received token for service account %s/%s expiring at %s", namespace, name, out.Status.ExpirationTimestamp)
+
+	d.SetId(fmt.Sprintf("%s/%s", namespace, name))
+
+	err = d.Set("audiences", out.Spec.Audiences)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("expiration_timestamp", out.Status.ExpirationTimestamp.UTC().Format(time.RFC3339))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("token", out.Status.Token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesServiceAccountTokenRead(ctx, d, meta)
+}
+
+// resourceKubernetesServiceAccountTokenRead only checks that the service account still exists,
+// issued tokens cannot be read back from the API.
+func resourceKubernetesServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Checking service account %s for token", name)
+	_, err = conn.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[INFO] Service account %s not found, token is no longer valid", d.Id())
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesServiceAccountTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only rotation_threshold_seconds can change in place, and it is only used when planning.
+	return resourceKubernetesServiceAccountTokenRead(ctx, d, meta)
+}
+
+// resourceKubernetesServiceAccountTokenDelete removes the token from state. The TokenRequest API
+// has no way to revoke a token, it stays valid until it expires or the service account is deleted.
+func resourceKubernetesServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Removing token for service account %s from state, it stays valid until %s", d.Id(), d.Get("expiration_timestamp"))
+	d.SetId("")
+	return nil
+}
+
+// resourceKubernetesServiceAccountTokenCustomizeDiff plans a new token once the remaining
+// lifetime of the current one is below rotation_threshold_seconds.
+func resourceKubernetesServiceAccountTokenCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	expiration, err := time.Parse(time.RFC3339, diff.Get("expiration_timestamp").(string))
+	if err != nil {
+		return nil
+	}
+	threshold := time.Duration(diff.Get("rotation_threshold_seconds").(int)) * time.Second
+	if time.Until(expiration) > threshold {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Token for service account %s expires at %s, planning rotation", diff.Id(), expiration)
+	err = diff.SetNewComputed("expiration_timestamp")
+	if err != nil {
+		return err
+	}
+	err = diff.SetNewComputed("token")
+	if err != nil {
+		return err
+	}
+	return diff.ForceNew("token")
+}
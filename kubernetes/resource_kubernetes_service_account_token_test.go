@@ -0,0 +1,107 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKubernetesServiceAccountToken_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_service_account_token.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.12.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceAccountTokenConfig_basic(name, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_name", name),
+					resource.TestCheckResourceAttr(resourceName, "audiences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "audiences.0", "vault"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_seconds", "3600"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_timestamp"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+			{
+				// The remaining lifetime is always below the threshold, so every plan rotates the token.
+				Config:             testAccKubernetesServiceAccountTokenConfig_basic(name, 7200),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestResourceKubernetesServiceAccountTokenCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		expiresIn      time.Duration
+		threshold      int
+		expectRotation bool
+	}{
+		{time.Hour, 300, false},
+		{4 * time.Minute, 300, true},
+		{-time.Minute, 0, true},
+		{time.Minute, 0, false},
+	}
+
+	r := resourceKubernetesServiceAccountToken()
+	for i, tc := range cases {
+		threshold := fmt.Sprintf("%d", tc.threshold)
+		state := &terraform.InstanceState{
+			ID: "default/test",
+			Attributes: map[string]string{
+				"id":                         "default/test",
+				"namespace":                  "default",
+				"service_account_name":       "test",
+				"audiences.#":                "1",
+				"audiences.0":                "api",
+				"expiration_seconds":         "3600",
+				"rotation_threshold_seconds": threshold,
+				"expiration_timestamp":       time.Now().Add(tc.expiresIn).UTC().Format(time.RFC3339),
+				"token":                      "token",
+			},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"service_account_name":       "test",
+			"rotation_threshold_seconds": tc.threshold,
+		})
+
+		diff, err := r.Diff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Fatalf("case %d: %s", i, err)
+		}
+		rotated := diff != nil && diff.RequiresNew()
+		if rotated != tc.expectRotation {
+			t.Fatalf("case %d: expected rotation %t, got diff %#v", i, tc.expectRotation, diff)
+		}
+	}
+}
+
+func testAccKubernetesServiceAccountTokenConfig_basic(name string, threshold int) string {
+	return fmt.Sprintf(`resource "kubernetes_service_account" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+resource "kubernetes_service_account_token" "test" {
+  service_account_name       = kubernetes_service_account.test.metadata.0.name
+  audiences                  = ["vault"]
+  expiration_seconds         = 3600
+  rotation_threshold_seconds = %d
+}
+`, name, threshold)
+}
@@ -35,19 +35,24 @@ func resourceKubernetesStatefulSet() *schema.Resource {
 				Type:    resourceKubernetesStatefulSetV0().CoreConfigSchema().ImpliedType(),
 				Upgrade: resourceKubernetesStatefulSetUpgradeV0,
 			},
+			{
+				Version: 1,
+				Type:    resourceKubernetesStatefulSetV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKubernetesStatefulSetUpgradeV1,
+			},
 		},
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
-		Schema: resourceKubernetesStatefulSetSchemaV1(),
+		Schema: resourceKubernetesStatefulSetSchemaV2(),
 	}
 }
 
-func resourceKubernetesStatefulSetSchemaV1() map[string]*schema.Schema {
+func resourceKubernetesStatefulSetSchemaV2() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"metadata": namespacedMetadataSchema("stateful set", true),
 		"spec": {
@@ -75,18 +80,22 @@ func resourceKubernetesStatefulSetCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+	statefulSet, err := expandStatefulSet(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	statefulSet := appsv1.StatefulSet{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new StatefulSet: %#v", statefulSet)
 
-	out, err := conn.AppsV1().StatefulSets(metadata.Namespace).Create(ctx, &statefulSet, metav1.CreateOptions{})
+	out := statefulSet
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.AppsV1().StatefulSets(statefulSet.Namespace).Create(ctx, statefulSet, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = statefulSet
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -105,7 +114,9 @@ func resourceKubernetesStatefulSetCreate(ctx context.Context, d *schema.Resource
 		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
 			retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name))
 		if err != nil {
-			return diag.FromErr(err)
+			dctx, cancel := diagnosticsContext(ctx)
+			defer cancel()
+			return diag.Errorf("%s%s", err, statefulSetRolloutDiagnostics(dctx, conn, namespace, name))
 		}
 	}
 
@@ -134,6 +145,17 @@ func resourceKubernetesStatefulSetExists(ctx context.Context, d *schema.Resource
 	return true, err
 }
 
+func expandStatefulSet(d *schema.ResourceData) (*appsv1.StatefulSet, error) {
+	spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.StatefulSet{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesStatefulSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesStatefulSetExists(ctx, d, meta)
 	if err != nil {
@@ -166,10 +188,10 @@ func resourceKubernetesStatefulSetRead(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 	log.Printf("[INFO] Received stateful set: %#v", statefulSet)
-	if d.Set("metadata", flattenMetadata(statefulSet.ObjectMeta, d)) != nil {
+	if d.Set("metadata", flattenMetadata(statefulSet.ObjectMeta, d, meta)) != nil {
 		return diag.Errorf("Error setting `metadata`: %+v", err)
 	}
-	sss, err := flattenStatefulSetSpec(statefulSet.Spec, d)
+	sss, err := flattenStatefulSetSpec(statefulSet.Spec, d, meta)
 	if err != nil {
 		return diag.Errorf("Error flattening `spec`: %+v", err)
 	}
@@ -190,25 +212,42 @@ func resourceKubernetesStatefulSetUpdate(ctx context.Context, d *schema.Resource
 	if err != nil {
 		return diag.Errorf("Error parsing resource ID: %#v", err)
 	}
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-
-	if d.HasChange("spec") {
-		log.Println("[TRACE] StatefulSet.Spec has changes")
-		specPatch, err := patchStatefulSetSpec(d)
+	var out *appsv1.StatefulSet
+	if useServerSideApply(meta) {
+		out, err = expandStatefulSet(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = applyObject(ctx, meta, out)
+		if err != nil {
+			return diag.Errorf("Failed to update StatefulSet: %s", err)
+		}
+	} else {
+		ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+			return conn.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		})
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		ops = append(ops, specPatch...)
-	}
 
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return diag.Errorf("Failed to marshal update operations for StatefulSet: %s", err)
-	}
-	log.Printf("[INFO] Updating StatefulSet %q: %v", name, string(data))
-	out, err := conn.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to update StatefulSet: %s", err)
+		if d.HasChange("spec") {
+			log.Println("[TRACE] StatefulSet.Spec has changes")
+			specPatch, err := patchStatefulSetSpec(d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			ops = append(ops, specPatch...)
+		}
+
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations for StatefulSet: %s", err)
+		}
+		log.Printf("[INFO] Updating StatefulSet %q: %v", name, string(data))
+		out, err = conn.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update StatefulSet: %s", err)
+		}
 	}
 	log.Printf("[INFO] Submitted updated StatefulSet: %#v", out)
 
@@ -217,7 +256,9 @@ func resourceKubernetesStatefulSetUpdate(ctx context.Context, d *schema.Resource
 		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
 			retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name))
 		if err != nil {
-			return diag.FromErr(err)
+			dctx, cancel := diagnosticsContext(ctx)
+			defer cancel()
+			return diag.Errorf("%s%s", err, statefulSetRolloutDiagnostics(dctx, conn, namespace, name))
 		}
 		return diag.Diagnostics{}
 	}
@@ -236,7 +277,7 @@ func resourceKubernetesStatefulSetDelete(ctx context.Context, d *schema.Resource
 		return diag.Errorf("Error parsing resource ID: %#v", err)
 	}
 	log.Printf("[INFO] Deleting StatefulSet: %#v", name)
-	err = conn.AppsV1().StatefulSets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.AppsV1().StatefulSets(namespace).Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
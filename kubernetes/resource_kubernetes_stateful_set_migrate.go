@@ -7,7 +7,7 @@ import (
 )
 
 func resourceKubernetesStatefulSetV0() *schema.Resource {
-	schemaV1 := resourceKubernetesStatefulSetSchemaV1()
+	schemaV1 := resourceKubernetesStatefulSetV1().Schema
 	schemaV0 := patchTemplatePodSpecWithResourcesFieldV0(schemaV1)
 	return &schema.Resource{Schema: schemaV0}
 }
@@ -15,3 +15,13 @@ func resourceKubernetesStatefulSetV0() *schema.Resource {
 func resourceKubernetesStatefulSetUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	return upgradeTemplatePodSpecWithResourcesFieldV0(ctx, rawState, meta)
 }
+
+func resourceKubernetesStatefulSetV1() *schema.Resource {
+	schemaV2 := resourceKubernetesStatefulSetSchemaV2()
+	schemaV1 := patchTemplatePodSpecWithSchedulingFieldsV1(schemaV2)
+	return &schema.Resource{Schema: schemaV1}
+}
+
+func resourceKubernetesStatefulSetUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return upgradeTemplatePodSpecWithSchedulingFieldsV1(ctx, rawState, meta)
+}
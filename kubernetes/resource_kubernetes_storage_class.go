@@ -109,12 +109,34 @@ func resourceKubernetesStorageClassCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	storageClass := expandStorageClass(d)
+
+	log.Printf("[INFO] Creating new storage class: %#v", storageClass)
+	out := storageClass
+	if useServerSideApply(meta) {
+		err = applyNewObject(ctx, d, meta, out)
+	} else {
+		out, err = conn.StorageV1().StorageClasses().Create(ctx, storageClass, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			out = storageClass
+			err = adoptObject(ctx, d, meta, out)
+		}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted new storage class: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesStorageClassRead(ctx, d, meta)
+}
+
+func expandStorageClass(d *schema.ResourceData) *api.StorageClass {
 	reclaimPolicy := v1.PersistentVolumeReclaimPolicy(d.Get("reclaim_policy").(string))
 	volumeBindingMode := api.VolumeBindingMode(d.Get("volume_binding_mode").(string))
 	allowVolumeExpansion := d.Get("allow_volume_expansion").(bool)
-	storageClass := api.StorageClass{
-		ObjectMeta:           metadata,
+	storageClass := &api.StorageClass{
+		ObjectMeta:           expandMetadata(d.Get("metadata").([]interface{})),
 		Provisioner:          d.Get("storage_provisioner").(string),
 		ReclaimPolicy:        &reclaimPolicy,
 		VolumeBindingMode:    &volumeBindingMode,
@@ -131,16 +153,7 @@ func resourceKubernetesStorageClassCreate(ctx context.Context, d *schema.Resourc
 	if v, ok := d.GetOk("allowed_topologies"); ok && len(v.([]interface{})) > 0 {
 		storageClass.AllowedTopologies = expandStorageClassAllowedTopologies(v.([]interface{}))
 	}
-
-	log.Printf("[INFO] Creating new storage class: %#v", storageClass)
-	out, err := conn.StorageV1().StorageClasses().Create(ctx, &storageClass, metav1.CreateOptions{})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	log.Printf("[INFO] Submitted new storage class: %#v", out)
-	d.SetId(out.Name)
-
-	return resourceKubernetesStorageClassRead(ctx, d, meta)
+	return storageClass
 }
 
 func resourceKubernetesStorageClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -168,7 +181,7 @@ func resourceKubernetesStorageClassRead(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[INFO] Received storage class: %#v", storageClass)
 
-	err = d.Set("metadata", flattenMetadata(storageClass.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(storageClass.ObjectMeta, d, meta))
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)[0])
 	}
@@ -184,13 +197,22 @@ func resourceKubernetesStorageClassRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceKubernetesStorageClassUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if useServerSideApply(meta) {
+		return applyResourceUpdate(ctx, d, meta, expandStorageClass(d), resourceKubernetesStorageClassRead)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		return conn.StorageV1().StorageClasses().Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
@@ -214,7 +236,7 @@ func resourceKubernetesStorageClassDelete(ctx context.Context, d *schema.Resourc
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting storage class: %#v", name)
-	err = conn.StorageV1().StorageClasses().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.StorageV1().StorageClasses().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
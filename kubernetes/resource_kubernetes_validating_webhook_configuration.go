@@ -10,6 +10,7 @@ import (
 	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
 
 	copier "github.com/jinzhu/copier"
@@ -119,10 +120,7 @@ func resourceKubernetesValidatingWebhookConfigurationCreate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	cfg := admissionregistrationv1.ValidatingWebhookConfiguration{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
-		Webhooks:   expandValidatingWebhooks(d.Get("webhook").([]interface{})),
-	}
+	cfg := expandValidatingWebhookConfiguration(d)
 
 	log.Printf("[INFO] Creating new ValidatingWebhookConfiguration: %#v", cfg)
 
@@ -132,14 +130,26 @@ func resourceKubernetesValidatingWebhookConfigurationCreate(ctx context.Context,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if useadmissionregistrationv1beta1 {
+	if useServerSideApply(meta) {
+		res, err = applyValidatingWebhookConfiguration(cfg, useadmissionregistrationv1beta1, func(obj runtime.Object) error {
+			return applyNewObject(ctx, d, meta, obj)
+		})
+	} else if useadmissionregistrationv1beta1 {
 		requestv1beta1 := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}
 		responsev1beta1 := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}
 		copier.Copy(requestv1beta1, cfg)
 		responsev1beta1, err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Create(ctx, requestv1beta1, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			responsev1beta1 = requestv1beta1
+			err = adoptObject(ctx, d, meta, responsev1beta1)
+		}
 		copier.Copy(res, responsev1beta1)
 	} else {
-		res, err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Create(ctx, &cfg, metav1.CreateOptions{})
+		res, err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Create(ctx, cfg, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) && adoptExisting(d, meta) {
+			res = cfg
+			err = adoptObject(ctx, d, meta, res)
+		}
 	}
 
 	if err != nil {
@@ -153,6 +163,32 @@ func resourceKubernetesValidatingWebhookConfigurationCreate(ctx context.Context,
 	return resourceKubernetesValidatingWebhookConfigurationRead(ctx, d, meta)
 }
 
+func expandValidatingWebhookConfiguration(d *schema.ResourceData) *admissionregistrationv1.ValidatingWebhookConfiguration {
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Webhooks:   expandValidatingWebhooks(d.Get("webhook").([]interface{})),
+	}
+}
+
+// applyValidatingWebhookConfiguration creates or updates cfg with the server-side apply function,
+// through admissionregistration.k8s.io/v1beta1 on clusters which do not serve v1.
+func applyValidatingWebhookConfiguration(cfg *admissionregistrationv1.ValidatingWebhookConfiguration, v1beta1 bool, apply func(obj runtime.Object) error) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
+	if !v1beta1 {
+		err := apply(cfg)
+		return cfg, err
+	}
+
+	requestv1beta1 := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}
+	copier.Copy(requestv1beta1, cfg)
+	err := apply(requestv1beta1)
+	if err != nil {
+		return nil, err
+	}
+	res := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	copier.Copy(res, requestv1beta1)
+	return res, nil
+}
+
 func resourceKubernetesValidatingWebhookConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesValidatingWebhookConfigurationExists(ctx, d, meta)
 	if err != nil {
@@ -186,7 +222,7 @@ func resourceKubernetesValidatingWebhookConfigurationRead(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d, meta))
 	if err != nil {
 		return nil
 	}
@@ -207,7 +243,33 @@ func resourceKubernetesValidatingWebhookConfigurationUpdate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if useServerSideApply(meta) {
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = applyValidatingWebhookConfiguration(expandValidatingWebhookConfiguration(d), useadmissionregistrationv1beta1, func(obj runtime.Object) error {
+			return applyObject(ctx, meta, obj)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceKubernetesValidatingWebhookConfigurationRead(ctx, d, meta)
+	}
+
+	ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+		if err != nil {
+			return nil, err
+		}
+		if useadmissionregistrationv1beta1 {
+			return conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(ctx, d.Id(), metav1.GetOptions{})
+		}
+		return conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, d.Id(), metav1.GetOptions{})
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	if d.HasChange("webhook") {
 		op := &ReplaceOperation{
@@ -275,9 +337,9 @@ func resourceKubernetesValidatingWebhookConfigurationDelete(ctx context.Context,
 		return diag.FromErr(err)
 	}
 	if useadmissionregistrationv1beta1 {
-		err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+		err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	} else {
-		err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+		err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, name, expandDeleteOptions(d, metav1.DeleteOptions{}))
 	}
 	if err != nil {
 		return diag.FromErr(err)
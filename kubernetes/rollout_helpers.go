@@ -0,0 +1,262 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	deploymentutil "k8s.io/kubectl/pkg/util/deployment"
+	"k8s.io/kubectl/pkg/util/podutils"
+)
+
+const (
+	rolloutDiagnosticsPodLimit   = 5
+	rolloutDiagnosticsEventLimit = 5
+)
+
+// rolloutDiagnostics describes why a workload did not finish rolling out,
+// and is appended to the error returned when waiting for the rollout fails.
+type rolloutDiagnostics struct {
+	// revision describes the ReplicaSet or ControllerRevision being rolled out
+	revision string
+	pods     []api.Pod
+	events   []api.Event
+}
+
+func (r rolloutDiagnostics) String() string {
+	var output string
+	if r.revision != "" {
+		output += "\n" + r.revision
+	}
+	var notReady []api.Pod
+	for _, p := range r.pods {
+		if !podutils.IsPodReady(&p) {
+			notReady = append(notReady, p)
+		}
+	}
+	if len(notReady) > 0 {
+		output += fmt.Sprintf("\nPods not ready (%d):", len(notReady))
+		for i, p := range notReady {
+			if i >= rolloutDiagnosticsPodLimit {
+				output += fmt.Sprintf("\n   * ... and %d more", len(notReady)-i)
+				break
+			}
+			output += stringifyPodStatus(p)
+		}
+	}
+	if len(r.events) > 0 {
+		output += "\nLatest warning events:" + stringifyEvents(r.events)
+	}
+	return output
+}
+
+// stringifyPodStatus lists the pod with the state of each of its containers.
+func stringifyPodStatus(pod api.Pod) string {
+	output := fmt.Sprintf("\n   * %s (%s)%s", pod.Name, pod.Status.Phase, podStatusMessage(&pod))
+	for _, c := range pod.Status.Conditions {
+		if c.Type == api.PodScheduled && c.Status == api.ConditionFalse {
+			output += fmt.Sprintf("\n     - not scheduled: %s: %s", c.Reason, c.Message)
+		}
+	}
+	for _, s := range pod.Status.InitContainerStatuses {
+		if !s.Ready {
+			output += "\n     - init " + stringifyContainerStatus(s)
+		}
+	}
+	for _, s := range pod.Status.ContainerStatuses {
+		if !s.Ready {
+			output += "\n     - " + stringifyContainerStatus(s)
+		}
+	}
+	return output
+}
+
+func stringifyContainerStatus(s api.ContainerStatus) string {
+	parts := []string{fmt.Sprintf("container %q", s.Name)}
+	switch {
+	case s.State.Waiting != nil:
+		state := "waiting: " + s.State.Waiting.Reason
+		if m := strings.TrimSpace(s.State.Waiting.Message); m != "" {
+			state += ": " + m
+		}
+		parts = append(parts, state)
+	case s.State.Terminated != nil:
+		parts = append(parts, "terminated: "+stringifyContainerTermination(s.State.Terminated))
+	case s.State.Running != nil:
+		parts = append(parts, "running, not ready")
+	}
+	parts = append(parts, fmt.Sprintf("restarts: %d", s.RestartCount))
+	if t := s.LastTerminationState.Terminated; t != nil {
+		parts = append(parts, "last termination: "+stringifyContainerTermination(t))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func stringifyContainerTermination(t *api.ContainerStateTerminated) string {
+	output := fmt.Sprintf("exit code %d", t.ExitCode)
+	if t.Reason != "" {
+		output += ", reason " + t.Reason
+	}
+	if m := strings.TrimSpace(t.Message); m != "" {
+		output += ", message: " + m
+	}
+	return output
+}
+
+// collectRolloutDiagnostics fills in the pods matching the selector which are
+// controlled by owner, and the latest warnings for them and the given objects.
+func collectRolloutDiagnostics(ctx context.Context, conn *kubernetes.Clientset, ns string, selector *metav1.LabelSelector, owner types.UID, uids []types.UID, r rolloutDiagnostics) rolloutDiagnostics {
+	if selector != nil {
+		pods, err := listOwnedPods(ctx, conn, ns, selector, owner)
+		if err != nil {
+			log.Printf("[WARN] Failed to list pods for rollout diagnostics: %s", err)
+		}
+		r.pods = pods
+		for _, p := range pods {
+			uids = append(uids, p.UID)
+		}
+	}
+
+	events, err := getLastWarningsForObjects(ctx, conn, ns, uids, rolloutDiagnosticsEventLimit)
+	if err != nil {
+		log.Printf("[WARN] Failed to list events for rollout diagnostics: %s", err)
+	}
+	r.events = events
+	return r
+}
+
+func listOwnedPods(ctx context.Context, conn *kubernetes.Clientset, ns string, selector *metav1.LabelSelector, owner types.UID) ([]api.Pod, error) {
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	out, err := conn.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: s.String()})
+	if err != nil {
+		return nil, err
+	}
+	var pods []api.Pod
+	for _, p := range out.Items {
+		if ref := metav1.GetControllerOf(&p); ref != nil && ref.UID == owner {
+			pods = append(pods, p)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	return pods, nil
+}
+
+// deploymentRolloutDiagnostics describes the newest ReplicaSet of the deployment and its pods.
+func deploymentRolloutDiagnostics(ctx context.Context, conn *kubernetes.Clientset, ns, name string) string {
+	dply, err := conn.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[WARN] Failed to read deployment for rollout diagnostics: %s", err)
+		return ""
+	}
+	_, _, newRS, err := deploymentutil.GetAllReplicaSets(dply, conn.AppsV1())
+	if err != nil {
+		log.Printf("[WARN] Failed to list replica sets for rollout diagnostics: %s", err)
+	}
+
+	r := rolloutDiagnostics{}
+	uids := []types.UID{dply.UID}
+	if newRS == nil {
+		r.revision = "No ReplicaSet was created for the current pod template"
+		return collectRolloutDiagnostics(ctx, conn, ns, nil, "", uids, r).String()
+	}
+
+	var replicas int32 = 1
+	if newRS.Spec.Replicas != nil {
+		replicas = *newRS.Spec.Replicas
+	}
+	r.revision = fmt.Sprintf("ReplicaSet %s (revision %s): %d of %d replicas ready",
+		newRS.Name, newRS.Annotations[deploymentutil.RevisionAnnotation], newRS.Status.ReadyReplicas, replicas)
+	uids = append(uids, newRS.UID)
+	return collectRolloutDiagnostics(ctx, conn, ns, dply.Spec.Selector, newRS.UID, uids, r).String()
+}
+
+// statefulSetRolloutDiagnostics describes the update revision of the StatefulSet and its pods.
+func statefulSetRolloutDiagnostics(ctx context.Context, conn *kubernetes.Clientset, ns, name string) string {
+	ss, err := conn.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[WARN] Failed to read StatefulSet for rollout diagnostics: %s", err)
+		return ""
+	}
+
+	r := rolloutDiagnostics{}
+	uids := []types.UID{ss.UID}
+	if rev := ss.Status.UpdateRevision; rev != "" {
+		cr, err := conn.AppsV1().ControllerRevisions(ns).Get(ctx, rev, metav1.GetOptions{})
+		if err != nil {
+			log.Printf("[WARN] Failed to read ControllerRevision for rollout diagnostics: %s", err)
+		} else {
+			uids = append(uids, cr.UID)
+			r.revision = fmt.Sprintf("ControllerRevision %s (revision %d): %d of %d replicas updated, %d ready",
+				cr.Name, cr.Revision, ss.Status.UpdatedReplicas, statefulSetReplicas(ss), ss.Status.ReadyReplicas)
+		}
+	}
+	return collectRolloutDiagnostics(ctx, conn, ns, ss.Spec.Selector, ss.UID, uids, r).String()
+}
+
+func statefulSetReplicas(ss *appsv1.StatefulSet) int32 {
+	if ss.Spec.Replicas == nil {
+		return 1
+	}
+	return *ss.Spec.Replicas
+}
+
+// daemonSetRolloutDiagnostics describes the newest ControllerRevision of the DaemonSet and its pods.
+func daemonSetRolloutDiagnostics(ctx context.Context, conn *kubernetes.Clientset, ns, name string) string {
+	ds, err := conn.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[WARN] Failed to read daemonset for rollout diagnostics: %s", err)
+		return ""
+	}
+
+	r := rolloutDiagnostics{}
+	uids := []types.UID{ds.UID}
+	cr, err := newestControllerRevision(ctx, conn, ns, ds.Spec.Selector, ds.UID)
+	if err != nil {
+		log.Printf("[WARN] Failed to list ControllerRevisions for rollout diagnostics: %s", err)
+	}
+	if cr != nil {
+		uids = append(uids, cr.UID)
+		r.revision = fmt.Sprintf("ControllerRevision %s (revision %d): %d of %d pods updated, %d ready, %d unavailable",
+			cr.Name, cr.Revision, ds.Status.UpdatedNumberScheduled, ds.Status.DesiredNumberScheduled,
+			ds.Status.NumberReady, ds.Status.NumberUnavailable)
+	}
+	return collectRolloutDiagnostics(ctx, conn, ns, ds.Spec.Selector, ds.UID, uids, r).String()
+}
+
+func newestControllerRevision(ctx context.Context, conn *kubernetes.Clientset, ns string, selector *metav1.LabelSelector, owner types.UID) (*appsv1.ControllerRevision, error) {
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	out, err := conn.AppsV1().ControllerRevisions(ns).List(ctx, metav1.ListOptions{LabelSelector: s.String()})
+	if err != nil {
+		return nil, err
+	}
+	return newestOwnedControllerRevision(out.Items, owner), nil
+}
+
+func newestOwnedControllerRevision(revisions []appsv1.ControllerRevision, owner types.UID) *appsv1.ControllerRevision {
+	var newest *appsv1.ControllerRevision
+	for i, cr := range revisions {
+		ref := metav1.GetControllerOf(&revisions[i])
+		if ref == nil || ref.UID != owner {
+			continue
+		}
+		if newest == nil || cr.Revision > newest.Revision {
+			newest = &revisions[i]
+		}
+	}
+	return newest
+}
@@ -0,0 +1,169 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestRolloutDiagnosticsString(t *testing.T) {
+	ready := api.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1"},
+		Status: api.PodStatus{
+			Phase:      api.PodRunning,
+			Conditions: []api.PodCondition{{Type: api.PodReady, Status: api.ConditionTrue}},
+		},
+	}
+	crashing := api.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-2"},
+		Status: api.PodStatus{
+			Phase: api.PodRunning,
+			ContainerStatuses: []api.ContainerStatus{
+				{
+					Name:         "app",
+					RestartCount: 4,
+					State:        api.ContainerState{Waiting: &api.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 40s restarting failed container"}},
+					LastTerminationState: api.ContainerState{
+						Terminated: &api.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
+					},
+				},
+				{Name: "sidecar", Ready: true, State: api.ContainerState{Running: &api.ContainerStateRunning{}}},
+			},
+		},
+	}
+	unschedulable := api.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-3"},
+		Status: api.PodStatus{
+			Phase: api.PodPending,
+			Conditions: []api.PodCondition{{
+				Type:    api.PodScheduled,
+				Status:  api.ConditionFalse,
+				Reason:  "Unschedulable",
+				Message: "0/3 nodes are available: 3 Insufficient memory.",
+			}},
+		},
+	}
+	r := rolloutDiagnostics{
+		revision: "ReplicaSet web-5d8f7 (revision 2): 1 of 3 replicas ready",
+		pods:     []api.Pod{ready, crashing, unschedulable},
+		events: []api.Event{{
+			InvolvedObject: api.ObjectReference{Name: "web-2", Kind: "Pod"},
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+		}},
+	}
+
+	expected := `
+ReplicaSet web-5d8f7 (revision 2): 1 of 3 replicas ready
+Pods not ready (2):
+   * web-2 (Running)
+     - container "app", waiting: CrashLoopBackOff: back-off 40s restarting failed container, restarts: 4, last termination: exit code 1, reason Error
+   * web-3 (Pending)
+     - not scheduled: Unschedulable: 0/3 nodes are available: 3 Insufficient memory.
+Latest warning events:
+   * web-2 (Pod): BackOff: Back-off restarting failed container`
+	if s := r.String(); s != expected {
+		t.Fatalf("Unexpected diagnostics, expected:%s\n\ngiven:%s", expected, s)
+	}
+}
+
+func TestRolloutDiagnosticsString_podLimit(t *testing.T) {
+	r := rolloutDiagnostics{}
+	for i := 0; i < rolloutDiagnosticsPodLimit+2; i++ {
+		r.pods = append(r.pods, api.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("web-%d", i)},
+			Status:     api.PodStatus{Phase: api.PodPending},
+		})
+	}
+	s := r.String()
+	if !strings.Contains(s, "... and 2 more") {
+		t.Fatalf("Expected the pods above the limit to be summarized, given:%s", s)
+	}
+	if strings.Contains(s, fmt.Sprintf("web-%d", rolloutDiagnosticsPodLimit)) {
+		t.Fatalf("Expected at most %d pods to be listed, given:%s", rolloutDiagnosticsPodLimit, s)
+	}
+}
+
+func TestNewestOwnedControllerRevision(t *testing.T) {
+	isController := true
+	revision := func(name string, revision int64, owner types.UID) appsv1.ControllerRevision {
+		return appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				OwnerReferences: []metav1.OwnerReference{{UID: owner, Controller: &isController}},
+			},
+			Revision: revision,
+		}
+	}
+	revisions := []appsv1.ControllerRevision{
+		revision("one", 1, "ds"),
+		revision("three", 3, "ds"),
+		revision("other", 7, "other-ds"),
+		revision("two", 2, "ds"),
+	}
+
+	if cr := newestOwnedControllerRevision(revisions, "ds"); cr == nil || cr.Name != "three" {
+		t.Fatalf("Expected revision %q, given: %#v", "three", cr)
+	}
+	if cr := newestOwnedControllerRevision(revisions, "missing"); cr != nil {
+		t.Fatalf("Expected no revision, given: %#v", cr)
+	}
+}
+
+func TestLastUniqueWarnings(t *testing.T) {
+	now := time.Now()
+	event := func(name, eventType, message string, age time.Duration) api.Event {
+		return api.Event{
+			ObjectMeta:    metav1.ObjectMeta{Name: name},
+			Type:          eventType,
+			Message:       message,
+			LastTimestamp: metav1.NewTime(now.Add(-age)),
+		}
+	}
+	events := []api.Event{
+		event("old", api.EventTypeWarning, "Back-off", 3*time.Minute),
+		event("normal", api.EventTypeNormal, "Pulled", 0),
+		event("latest", api.EventTypeWarning, "Back-off", time.Minute),
+		event("failed", api.EventTypeWarning, "Failed to pull image", 2*time.Minute),
+		event("oldest", api.EventTypeWarning, "Insufficient memory", 4*time.Minute),
+	}
+
+	warnings := lastUniqueWarnings(events, 2)
+	var names []string
+	for _, e := range warnings {
+		names = append(names, e.Name)
+	}
+	if strings.Join(names, ",") != "latest,failed" {
+		t.Fatalf("Unexpected warnings: %q", names)
+	}
+}
+
+func TestDiagnosticsContext(t *testing.T) {
+	expired, cancelExpired := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancelExpired()
+	<-expired.Done()
+
+	ctx, cancel := diagnosticsContext(expired)
+	defer cancel()
+	if ctx.Err() != nil {
+		t.Fatalf("Expected a live context once the resource timeout expired, given: %s", ctx.Err())
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		t.Fatal("Expected the diagnostics context to have a deadline")
+	}
+
+	canceled, cancelParent := context.WithCancel(context.Background())
+	cancelParent()
+	ctx, cancel = diagnosticsContext(canceled)
+	defer cancel()
+	if ctx.Err() == nil {
+		t.Fatal("Expected a canceled context to stay canceled")
+	}
+}
@@ -20,10 +20,11 @@ func backendSpecFields(description string) *schema.Schema {
 				},
 				"service_port": {
 					Type:        schema.TypeString,
-					Description: "Specifies the port of the referenced service.",
+					Description: "Specifies the port of the referenced service, by number or by name.",
 					Computed:    true,
 					Optional:    true,
 				},
+				"resource": typedLocalObjectReferenceSchema("Resource is an ObjectRef to another Kubernetes resource in the namespace of the Ingress object. It cannot be set together with `service_name`."),
 			},
 		},
 	}
@@ -185,6 +185,58 @@ func seLinuxOptionsField(isUpdatable bool) map[string]*schema.Schema {
 	}
 }
 
+func ephemeralContainerFields() map[string]*schema.Schema {
+	s := containerFields(true)
+	s["target_container_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "If set, the name of the container from the pod spec that this ephemeral container targets. The ephemeral container will be run in the namespaces (IPC, PID, etc) of this container.",
+	}
+	return s
+}
+
+func seccompProfileField(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"localhost_profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Description: "Localhost Profile indicates a profile defined in a file on the node should be used. The profile must be preconfigured on the node to work. Must be a descending path, relative to the kubelet's configured seccomp profile location. Must only be set if type is `Localhost`.",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     !isUpdatable,
+			ValidateFunc: validation.StringInSlice([]string{"Localhost", "RuntimeDefault", "Unconfined"}, false),
+			Description:  "Type indicates which kind of seccomp profile will be applied. Valid options are: Localhost - a profile defined in a file on the node should be used. RuntimeDefault - the container runtime default profile should be used. Unconfined - no profile should be applied.",
+		},
+	}
+}
+
+func windowsOptionsField(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"gmsa_credential_spec": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    !isUpdatable,
+			Description: "GMSACredentialSpec is where the GMSA admission webhook inlines the contents of the GMSA credential spec named by the `gmsa_credential_spec_name` field.",
+		},
+		"gmsa_credential_spec_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Description: "GMSACredentialSpecName is the name of the GMSA credential spec to use.",
+		},
+		"run_as_username": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Description: "The UserName in Windows to run the entrypoint of the container process. Defaults to the user specified in image metadata if unspecified. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.",
+		},
+	}
+}
+
 func volumeMountFields() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"mount_path": {
@@ -737,6 +789,26 @@ func securityContextSchema(isUpdatable bool) *schema.Resource {
 				Schema: seLinuxOptionsField(isUpdatable),
 			},
 		},
+		"seccomp_profile": {
+			Type:        schema.TypeList,
+			Description: "The seccomp options to use by this container. If seccomp options are provided at both the pod & container level, the container options override the pod options.",
+			Optional:    true,
+			MaxItems:    1,
+			ForceNew:    !isUpdatable,
+			Elem: &schema.Resource{
+				Schema: seccompProfileField(isUpdatable),
+			},
+		},
+		"windows_options": {
+			Type:        schema.TypeList,
+			Description: "The Windows specific settings applied to this container. If unspecified, the options from the PodSecurityContext will be used. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.",
+			Optional:    true,
+			MaxItems:    1,
+			ForceNew:    !isUpdatable,
+			Elem: &schema.Resource{
+				Schema: windowsOptionsField(isUpdatable),
+			},
+		},
 	}
 
 	return &schema.Resource{
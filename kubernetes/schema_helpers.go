@@ -1,5 +1,9 @@
 package kubernetes
 
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
 func conditionalDefault(condition bool, defaultValue interface{}) interface{} {
 	if !condition {
 		return nil
@@ -7,3 +11,71 @@ func conditionalDefault(condition bool, defaultValue interface{}) interface{} {
 
 	return defaultValue
 }
+
+// computedSchemaFields copies resource fields for use in a data source, where
+// every attribute is read from the API and none can be configured.
+func computedSchemaFields(fields map[string]*schema.Schema) map[string]*schema.Schema {
+	computed := make(map[string]*schema.Schema, len(fields))
+	for k, v := range fields {
+		computed[k] = computedSchema(v)
+	}
+	return computed
+}
+
+func computedSchema(s *schema.Schema) *schema.Schema {
+	c := &schema.Schema{
+		Type:        s.Type,
+		Description: s.Description,
+		Computed:    true,
+		Set:         s.Set,
+		ConfigMode:  s.ConfigMode,
+	}
+	switch e := s.Elem.(type) {
+	case *schema.Resource:
+		c.Elem = &schema.Resource{Schema: computedSchemaFields(e.Schema)}
+	case *schema.Schema:
+		c.Elem = &schema.Schema{Type: e.Type}
+	}
+	return c
+}
+
+// schemaSetForceNew overrides ForceNew on the fields and their nested fields, so the
+// schema of an object can be embedded in a template which is updatable
+func schemaSetForceNew(fields map[string]*schema.Schema, forceNew bool) map[string]*schema.Schema {
+	for _, v := range fields {
+		v.ForceNew = forceNew
+		if r, ok := v.Elem.(*schema.Resource); ok {
+			schemaSetForceNew(r.Schema, forceNew)
+		}
+	}
+	return fields
+}
+
+// typedLocalObjectReferenceSchema describes a reference to an object of any kind
+func typedLocalObjectReferenceSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"api_group": {
+					Type:        schema.TypeString,
+					Description: "APIGroup is the group for the resource being referenced. If it is not specified, the specified kind must be in the core API group.",
+					Optional:    true,
+				},
+				"kind": {
+					Type:        schema.TypeString,
+					Description: "Kind is the type of resource being referenced.",
+					Required:    true,
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name is the name of resource being referenced.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
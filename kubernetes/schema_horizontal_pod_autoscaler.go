@@ -1,6 +1,10 @@
 package kubernetes
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+)
 
 func metricTargetFields() *schema.Resource {
 	return &schema.Resource{
@@ -199,3 +203,159 @@ func metricSpecFields() *schema.Resource {
 		},
 	}
 }
+
+func horizontalPodAutoscalerBehaviorFields() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"scale_down": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        scalingRulesFields(300),
+				Description: "Scaling policy for scaling Down. If not set, the default value is to allow to scale down to minReplicas pods, with a 300 second stabilization window (i.e., the highest recommendation for the last 300sec is used).",
+			},
+			"scale_up": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        scalingRulesFields(0),
+				Description: "Scaling policy for scaling Up. If not set, the default value is the higher of: increase no more than 4 pods per 15 seconds, or double the number of pods per 15 seconds. No stabilization is used.",
+			},
+		},
+	}
+}
+
+func scalingRulesFields(defaultStabilizationWindowSeconds int) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        scalingPolicyFields(),
+				Description: "List of potential scaling polices which can be used during scaling. If not set, the default policies of the direction are used.",
+			},
+			"select_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(autoscalingv2beta2.MaxPolicySelect),
+				ValidateFunc: validation.StringInSlice([]string{string(autoscalingv2beta2.MaxPolicySelect), string(autoscalingv2beta2.MinPolicySelect), string(autoscalingv2beta2.DisabledPolicySelect)}, false),
+				Description:  "Used to specify which policy should be used. One of `Max`, `Min` or `Disabled`, which turns off scaling in this direction. Defaults to `Max`.",
+			},
+			"stabilization_window_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultStabilizationWindowSeconds,
+				ValidateFunc: validation.IntBetween(0, 3600),
+				Description:  "Number of seconds for which past recommendations should be considered while scaling up or scaling down. This value must be greater than or equal to zero and less than or equal to 3600 (one hour).",
+			},
+		},
+	}
+}
+
+func scalingPolicyFields() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"period_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1800),
+				Description:  "Period specifies the window of time for which the policy should hold true. PeriodSeconds must be greater than zero and less than or equal to 1800 (30 min).",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(autoscalingv2beta2.PodsScalingPolicy), string(autoscalingv2beta2.PercentScalingPolicy)}, false),
+				Description:  "Type is used to specify the scaling policy, `Pods` or `Percent`.",
+			},
+			"value": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Value contains the amount of change which is permitted by the policy. It must be greater than zero.",
+			},
+		},
+	}
+}
+
+func horizontalPodAutoscalerStatusFields() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"condition": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Conditions needed for this autoscaler to scale its target, and whether or not those conditions are met.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_transition_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"current_metric": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Last read state of the metrics used by this autoscaler.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"average_utilization": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"average_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"current_replicas": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Current number of replicas of pods managed by this autoscaler, as last seen by the autoscaler.",
+			},
+			"desired_replicas": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Desired number of replicas of pods managed by this autoscaler, as last calculated by the autoscaler.",
+			},
+			"last_scale_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last time the autoscaler scaled the number of pods, in RFC3339 format.",
+			},
+		},
+	}
+}
@@ -15,7 +15,7 @@ func jobMetadataSchema() *schema.Schema {
 
 func jobSpecFields() map[string]*schema.Schema {
 	podTemplateFields := map[string]*schema.Schema{
-		"metadata": metadataSchema("job", true),
+		"metadata": templateMetadataSchema("job", true),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Spec of the pods owned by the job",
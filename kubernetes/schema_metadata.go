@@ -15,6 +15,13 @@ func metadataFields(objectName string) map[string]*schema.Schema {
 			Elem:         &schema.Schema{Type: schema.TypeString},
 			ValidateFunc: validateAnnotations,
 		},
+		"finalizers": {
+			Type:             schema.TypeList,
+			Description:      fmt.Sprintf("Must be empty before the %s is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. Finalizers added by Kubernetes itself, e.g. `kubernetes.io/pvc-protection`, are kept when not configured. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/", objectName),
+			Optional:         true,
+			Elem:             &schema.Schema{Type: schema.TypeString},
+			DiffSuppressFunc: suppressInternalFinalizersDiff,
+		},
 		"generation": {
 			Type:        schema.TypeInt,
 			Description: "A sequence number representing a specific generation of the desired state.",
@@ -35,6 +42,14 @@ func metadataFields(objectName string) map[string]*schema.Schema {
 			Computed:     true,
 			ValidateFunc: validateName,
 		},
+		"owner_references": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("List of objects depended by this %s. If all objects in the list have been deleted, this object will be garbage collected. More info: https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/", objectName),
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: ownerReferenceFields(),
+			},
+		},
 		"resource_version": {
 			Type:        schema.TypeString,
 			Description: fmt.Sprintf("An opaque value that represents the internal version of this %s that can be used by clients to determine when %s has changed. Read more: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency", objectName, objectName),
@@ -53,6 +68,41 @@ func metadataFields(objectName string) map[string]*schema.Schema {
 	}
 }
 
+func ownerReferenceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Type:        schema.TypeString,
+			Description: "API version of the referent.",
+			Required:    true,
+		},
+		"block_owner_deletion": {
+			Type:        schema.TypeBool,
+			Description: "If true, AND if the owner has the \"foregroundDeletion\" finalizer, then the owner cannot be deleted from the key-value store until this reference is removed.",
+			Optional:    true,
+		},
+		"controller": {
+			Type:        schema.TypeBool,
+			Description: "If true, this reference points to the managing controller.",
+			Optional:    true,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Description: "Kind of the referent. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+			Required:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+			Required:    true,
+		},
+		"uid": {
+			Type:        schema.TypeString,
+			Description: "UID of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#uids",
+			Required:    true,
+		},
+	}
+}
+
 func metadataSchema(objectName string, generatableName bool) *schema.Schema {
 	fields := metadataFields(objectName)
 
@@ -79,6 +129,21 @@ func metadataSchema(objectName string, generatableName bool) *schema.Schema {
 	}
 }
 
+// templateMetadataSchema returns the metadata schema of an object template, e.g. the pod template
+// of a workload, without the fields which only apply to the object itself.
+func templateMetadataSchema(objectName string, generatableName bool) *schema.Schema {
+	s := metadataSchema(objectName, generatableName)
+	removeObjectOnlyMetadataFields(s.Elem.(*schema.Resource).Schema)
+	return s
+}
+
+// removeObjectOnlyMetadataFields removes the finalizers and owner references,
+// which are set on the objects created from a template rather than in it.
+func removeObjectOnlyMetadataFields(fields map[string]*schema.Schema) {
+	delete(fields, "finalizers")
+	delete(fields, "owner_references")
+}
+
 func metadataSchemaForceNew(s *schema.Schema) *schema.Schema {
 	s.ForceNew = true
 	return s
@@ -97,6 +162,9 @@ func namespacedMetadataSchemaIsTemplate(objectName string, generatableName, isTe
 		ForceNew:    true,
 		Default:     conditionalDefault(!isTemplate, "default"),
 	}
+	if isTemplate {
+		removeObjectOnlyMetadataFields(fields)
+	}
 	if generatableName {
 		fields["generate_name"] = &schema.Schema{
 			Type:          schema.TypeString,
@@ -0,0 +1,132 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// nodeMetadataSchema identifies an existing node, which the node resources
+// only manage partially. Nodes are registered by the kubelet, not by Terraform.
+func nodeMetadataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Metadata identifying the node.",
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Description:  "Name of the node. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validateName,
+				},
+			},
+		},
+	}
+}
+
+func flattenNodeMetadata(name string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"name": name,
+		},
+	}
+}
+
+func nodeSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"pod_cidr": {
+			Type:        schema.TypeString,
+			Description: "The pod IP range assigned to the node.",
+			Computed:    true,
+		},
+		"pod_cidrs": {
+			Type:        schema.TypeList,
+			Description: "The IP ranges assigned to the node for usage by pods, one per IP family.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"provider_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the node assigned by the cloud provider.",
+			Computed:    true,
+		},
+		"unschedulable": {
+			Type:        schema.TypeBool,
+			Description: "Whether new pods can be scheduled on the node.",
+			Computed:    true,
+		},
+		"taint": {
+			Type:        schema.TypeList,
+			Description: "The taints of the node.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"effect": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func nodeStatusFields() map[string]*schema.Schema {
+	nodeInfo := make(map[string]*schema.Schema)
+	for _, k := range []string{"architecture", "boot_id", "container_runtime_version", "kernel_version", "kube_proxy_version", "kubelet_version", "machine_id", "operating_system", "os_image", "system_uuid"} {
+		nodeInfo[k] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"addresses": {
+			Type:        schema.TypeList,
+			Description: "Addresses reachable to the node, e.g. of type InternalIP, ExternalIP or Hostname.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"allocatable": {
+			Type:        schema.TypeMap,
+			Description: "The resources of the node available for scheduling.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"capacity": {
+			Type:        schema.TypeMap,
+			Description: "The total resources of the node.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"node_info": {
+			Type:        schema.TypeList,
+			Description: "General information about the node, e.g. the kubelet and OS versions.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: nodeInfo,
+			},
+		},
+	}
+}
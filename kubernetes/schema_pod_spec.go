@@ -219,6 +219,22 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			ForceNew:    !isUpdatable,
 			Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: http://kubernetes.io/docs/user-guide/node-selection.",
 		},
+		"overhead": {
+			Type:         schema.TypeMap,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     !isUpdatable,
+			ValidateFunc: validateResourceList,
+			Description:  "Overhead represents the resource overhead associated with running a pod for a given RuntimeClass. It is usually set by the RuntimeClass admission controller from the `overhead` of the RuntimeClass named in `runtime_class_name`. More info: https://kubernetes.io/docs/concepts/scheduling-eviction/pod-overhead/",
+		},
+		"preemption_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     !isUpdatable,
+			ValidateFunc: validation.StringInSlice([]string{"PreemptLowerPriority", "Never"}, false),
+			Description:  "PreemptionPolicy is the Policy for preempting pods with lower priority. One of Never, PreemptLowerPriority. Defaults to PreemptLowerPriority if unset.",
+		},
 		"priority_class_name": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -234,6 +250,20 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			Default:     conditionalDefault(!isComputed, "Always"),
 			Description: "Restart policy for all containers within the pod. One of Always, OnFailure, Never. More info: http://kubernetes.io/docs/user-guide/pod-states#restartpolicy.",
 		},
+		"runtime_class_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    isComputed,
+			ForceNew:    !isUpdatable,
+			Description: "RuntimeClassName is the name of a RuntimeClass object which should be used to run this pod. If no RuntimeClass resource matches the named class, the pod will not be run. If unset or empty, the default runtime handler is used. More info: https://kubernetes.io/docs/concepts/containers/runtime-class/",
+		},
+		"scheduler_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    !isUpdatable,
+			Description: "If specified, the pod will be dispatched by specified scheduler. If not specified, the pod will be dispatched by default scheduler.",
+		},
 		"security_context": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -249,6 +279,13 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 						ValidateFunc: validateTypeStringNullableInt,
 						ForceNew:     !isUpdatable,
 					},
+					"fs_group_change_policy": {
+						Type:         schema.TypeString,
+						Description:  "Defines behavior of changing ownership and permission of the volume before being exposed inside the pod. This field will only apply to volume types which support fsGroup based ownership (and permissions). It will have no effect on ephemeral volume types such as secret, configmaps and emptydir. Valid values are `OnRootMismatch` and `Always`. If not specified, `Always` is used.",
+						Optional:     true,
+						ForceNew:     !isUpdatable,
+						ValidateFunc: validation.StringInSlice([]string{"OnRootMismatch", "Always"}, false),
+					},
 					"run_as_group": {
 						Type:         schema.TypeString,
 						Description:  "The GID to run the entrypoint of the container process. Uses runtime default if unset. May also be set in SecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence for that container.",
@@ -278,6 +315,16 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 							Schema: seLinuxOptionsField(isUpdatable),
 						},
 					},
+					"seccomp_profile": {
+						Type:        schema.TypeList,
+						Description: "The seccomp options to use by the containers in this pod.",
+						Optional:    true,
+						MaxItems:    1,
+						ForceNew:    !isUpdatable,
+						Elem: &schema.Resource{
+							Schema: seccompProfileField(isUpdatable),
+						},
+					},
 					"supplemental_groups": {
 						Type:        schema.TypeSet,
 						Description: "A list of groups applied to the first process run in each container, in addition to the container's primary GID. If unspecified, no groups will be added to any container.",
@@ -308,6 +355,16 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 							},
 						},
 					},
+					"windows_options": {
+						Type:        schema.TypeList,
+						Description: "The Windows specific settings applied to all containers. If unspecified, the options within a container's SecurityContext will be used. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.",
+						Optional:    true,
+						MaxItems:    1,
+						ForceNew:    !isUpdatable,
+						Elem: &schema.Resource{
+							Schema: windowsOptionsField(isUpdatable),
+						},
+					},
 				},
 			},
 		},
@@ -318,6 +375,13 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			ForceNew:    !isUpdatable,
 			Description: "ServiceAccountName is the name of the ServiceAccount to use to run this pod. More info: http://releases.k8s.io/HEAD/docs/design/service_accounts.md.",
 		},
+		"set_hostname_as_fqdn": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    isComputed,
+			ForceNew:    !isUpdatable,
+			Description: "If true the pod's hostname will be configured as the pod's FQDN, rather than the leaf name (the default). In Linux containers, this means setting the FQDN in the hostname field of the kernel (the nodename field of struct utsname). Defaults to false.",
+		},
 		"share_process_namespace": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -346,43 +410,17 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			Optional:    true,
 			Description: "If specified, the pod's toleration. Optional: Defaults to empty",
 			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"effect": {
-						Type:         schema.TypeString,
-						Description:  "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
-						Optional:     true,
-						ForceNew:     !isUpdatable,
-						ValidateFunc: validation.StringInSlice([]string{"NoSchedule", "PreferNoSchedule", "NoExecute"}, false),
-					},
-					"key": {
-						Type:        schema.TypeString,
-						Description: "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
-						Optional:    true,
-						ForceNew:    !isUpdatable,
-					},
-					"operator": {
-						Type:         schema.TypeString,
-						Description:  "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
-						Default:      "Equal",
-						Optional:     true,
-						ForceNew:     !isUpdatable,
-						ValidateFunc: validation.StringInSlice([]string{"Exists", "Equal"}, false),
-					},
-					"toleration_seconds": {
-						// Use TypeString to allow an "unspecified" value,
-						Type:         schema.TypeString,
-						Description:  "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.",
-						Optional:     true,
-						ForceNew:     !isUpdatable,
-						ValidateFunc: validateTypeStringNullableInt,
-					},
-					"value": {
-						Type:        schema.TypeString,
-						Description: "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
-						Optional:    true,
-						ForceNew:    !isUpdatable,
-					},
-				},
+				Schema: tolerationFields(isUpdatable),
+			},
+		},
+		"topology_spread_constraint": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    isComputed,
+			ForceNew:    !isUpdatable,
+			Description: "Describes how a group of pods ought to spread across topology domains. Scheduler will schedule pods in a way which abides by the constraints. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-topology-spread-constraints/",
+			Elem: &schema.Resource{
+				Schema: topologySpreadConstraintFields(isUpdatable),
 			},
 		},
 		"volume": {
@@ -396,6 +434,83 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 	return s
 }
 
+func tolerationFields(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"effect": {
+			Type:         schema.TypeString,
+			Description:  "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
+			Optional:     true,
+			ForceNew:     !isUpdatable,
+			ValidateFunc: validation.StringInSlice([]string{"NoSchedule", "PreferNoSchedule", "NoExecute"}, false),
+		},
+		"key": {
+			Type:        schema.TypeString,
+			Description: "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+		},
+		"operator": {
+			Type:         schema.TypeString,
+			Description:  "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
+			Default:      "Equal",
+			Optional:     true,
+			ForceNew:     !isUpdatable,
+			ValidateFunc: validation.StringInSlice([]string{"Exists", "Equal"}, false),
+		},
+		"toleration_seconds": {
+			// Use TypeString to allow an "unspecified" value,
+			Type:         schema.TypeString,
+			Description:  "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.",
+			Optional:     true,
+			ForceNew:     !isUpdatable,
+			ValidateFunc: validateTypeStringNullableInt,
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Description: "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+		},
+	}
+}
+
+func topologySpreadConstraintFields(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"label_selector": {
+			Type:        schema.TypeList,
+			Description: "A label query over a set of resources, in this case pods. Pods that match this label selector are counted to determine the number of pods in their corresponding topology domain.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(isUpdatable),
+			},
+		},
+		"max_skew": {
+			Type:         schema.TypeInt,
+			Description:  "Describes the degree to which pods may be unevenly distributed. It is the maximum permitted difference between the number of matching pods in any two topology domains of a given topology type.",
+			Optional:     true,
+			Default:      1,
+			ForceNew:     !isUpdatable,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"topology_key": {
+			Type:        schema.TypeString,
+			Description: "The key of node labels. Nodes that have a label with this key and identical values are considered to be in the same topology.",
+			Required:    true,
+			ForceNew:    !isUpdatable,
+		},
+		"when_unsatisfiable": {
+			Type:         schema.TypeString,
+			Description:  "Indicates how to deal with a pod if it doesn't satisfy the spread constraint. `DoNotSchedule` (default) tells the scheduler not to schedule it, `ScheduleAnyway` tells the scheduler to schedule the pod in any location, but giving higher precedence to topologies that would help reduce the skew.",
+			Optional:     true,
+			Default:      "DoNotSchedule",
+			ForceNew:     !isUpdatable,
+			ValidateFunc: validation.StringInSlice([]string{"DoNotSchedule", "ScheduleAnyway"}, false),
+		},
+	}
+}
+
 func volumeSchema(isUpdatable bool) *schema.Resource {
 	v := commonVolumeSources()
 
@@ -565,6 +680,118 @@ func volumeSchema(isUpdatable bool) *schema.Resource {
 		},
 	}
 
+	// The csi volume source of a pod is the inline CSIVolumeSource, not the
+	// CSIPersistentVolumeSource the persistent volume shares in commonVolumeSources
+	v["csi"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Represents an ephemeral volume that is handled by an external CSI driver. More info: https://kubernetes.io/docs/concepts/storage/volumes/#csi",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"driver": {
+					Type:        schema.TypeString,
+					Description: "The name of the CSI driver that handles this volume. Consult with your admin for the correct name as registered in the cluster.",
+					Required:    true,
+					ForceNew:    !isUpdatable,
+				},
+				"fs_type": {
+					Type:        schema.TypeString,
+					Description: `Filesystem type to mount. Ex. "ext4", "xfs", "ntfs". If not provided, the empty value is passed to the associated CSI driver which will determine the default filesystem to apply.`,
+					Optional:    true,
+					ForceNew:    !isUpdatable,
+				},
+				"node_publish_secret_ref": {
+					Type:        schema.TypeList,
+					Description: "A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI NodePublishVolume and NodeUnpublishVolume calls.",
+					Optional:    true,
+					MaxItems:    1,
+					ForceNew:    !isUpdatable,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+								Optional:    true,
+								ForceNew:    !isUpdatable,
+							},
+						},
+					},
+				},
+				"read_only": {
+					Type:        schema.TypeBool,
+					Description: "Specifies a read-only configuration for the volume. Defaults to false (read/write).",
+					Optional:    true,
+					ForceNew:    !isUpdatable,
+				},
+				"volume_attributes": {
+					Type:        schema.TypeMap,
+					Description: "Driver-specific properties that are passed to the CSI driver.",
+					Optional:    true,
+					ForceNew:    !isUpdatable,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	v["ephemeral"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Represents a volume that is handled by a cluster storage driver. The volume's lifecycle is tied to the pod that defines it, it will be created before the pod starts, and deleted when the pod is removed. More info: https://kubernetes.io/docs/concepts/storage/ephemeral-volumes/#generic-ephemeral-volumes",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"volume_claim_template": {
+					Type:        schema.TypeList,
+					Description: "Will be used to create a stand-alone PVC to provision the volume. The pod in which this volume is embedded will be the owner of the PVC.",
+					Required:    true,
+					MaxItems:    1,
+					ForceNew:    !isUpdatable,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"metadata": {
+								Type:        schema.TypeList,
+								Description: "May contain labels and annotations that will be copied into the PVC when creating it.",
+								Optional:    true,
+								MaxItems:    1,
+								ForceNew:    !isUpdatable,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"annotations": {
+											Type:        schema.TypeMap,
+											Description: "An unstructured key value map stored with the persistent volume claim.",
+											Optional:    true,
+											ForceNew:    !isUpdatable,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+										},
+										"labels": {
+											Type:        schema.TypeMap,
+											Description: "Map of string keys and values that can be used to organize and categorize the persistent volume claim.",
+											Optional:    true,
+											ForceNew:    !isUpdatable,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+							"spec": {
+								Type:        schema.TypeList,
+								Description: "The specification for the PersistentVolumeClaim. The entire content is copied unchanged into the PVC that gets created from this template.",
+								Required:    true,
+								MaxItems:    1,
+								ForceNew:    !isUpdatable,
+								Elem: &schema.Resource{
+									Schema: schemaSetForceNew(persistentVolumeClaimSpecFields(), !isUpdatable),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
 	v["empty_dir"] = &schema.Schema{
 		Type:        schema.TypeList,
 		Description: "EmptyDir represents a temporary directory that shares a pod's lifetime. More info: http://kubernetes.io/docs/user-guide/volumes#emptydir",
@@ -0,0 +1,86 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// podSpecFieldsV2 returns the empty values of the pod spec fields added in version 2
+// of the schemas of the resources with a pod spec, the V1 schemas are patched to not have them
+func podSpecFieldsV2() map[string]interface{} {
+	return map[string]interface{}{
+		"overhead":                   map[string]interface{}{},
+		"preemption_policy":          "",
+		"runtime_class_name":         "",
+		"scheduler_name":             "",
+		"set_hostname_as_fqdn":       false,
+		"topology_spread_constraint": []interface{}{},
+	}
+}
+
+func patchJobTemplatePodSpecWithSchedulingFieldsV1(m map[string]*schema.Schema) map[string]*schema.Schema {
+	spec := m["spec"].Elem.(*schema.Resource)
+	jobTemplate := spec.Schema["job_template"].Elem.(*schema.Resource)
+	jobSpec := jobTemplate.Schema["spec"].Elem.(*schema.Resource)
+	template := jobSpec.Schema["template"].Elem.(*schema.Resource)
+	template.Schema = patchPodSpecWithSchedulingFieldsV1(template.Schema)
+	return m
+}
+
+func patchTemplatePodSpecWithSchedulingFieldsV1(m map[string]*schema.Schema) map[string]*schema.Schema {
+	spec := m["spec"].Elem.(*schema.Resource)
+	template := spec.Schema["template"].Elem.(*schema.Resource)
+	template.Schema = patchPodSpecWithSchedulingFieldsV1(template.Schema)
+	return m
+}
+
+func patchPodSpecWithSchedulingFieldsV1(m map[string]*schema.Schema) map[string]*schema.Schema {
+	spec := m["spec"].Elem.(*schema.Resource)
+	for k := range podSpecFieldsV2() {
+		delete(spec.Schema, k)
+	}
+	return m
+}
+
+func upgradeJobTemplatePodSpecWithSchedulingFieldsV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	upgradeSchedulingFields(rawBlock(rawState, "spec", "job_template", "spec", "template", "spec"))
+	return rawState, nil
+}
+
+func upgradeTemplatePodSpecWithSchedulingFieldsV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	upgradeSchedulingFields(rawBlock(rawState, "spec", "template", "spec"))
+	return rawState, nil
+}
+
+func upgradePodSpecWithSchedulingFieldsV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	upgradeSchedulingFields(rawBlock(rawState, "spec"))
+	return rawState, nil
+}
+
+// upgradeSchedulingFields sets the pod spec fields added in version 2 to their
+// empty values, which is what they are in the state of a pod spec that does not use them
+func upgradeSchedulingFields(podSpec map[string]interface{}) {
+	if podSpec == nil {
+		return
+	}
+	for k, v := range podSpecFieldsV2() {
+		if _, ok := podSpec[k]; !ok {
+			podSpec[k] = v
+		}
+	}
+}
+
+// rawBlock follows the path of single nested blocks in the raw state,
+// it returns nil when one of them is not set
+func rawBlock(rawState map[string]interface{}, path ...string) map[string]interface{} {
+	block := rawState
+	for _, k := range path {
+		l, ok := block[k].([]interface{})
+		if !ok || len(l) == 0 || l[0] == nil {
+			return nil
+		}
+		block = l[0].(map[string]interface{})
+	}
+	return block
+}
@@ -0,0 +1,105 @@
+package kubernetes
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUpgradeJobTemplatePodSpecWithSchedulingFieldsV1(t *testing.T) {
+	v1 := map[string]interface{}{
+		"spec": []interface{}{map[string]interface{}{
+			"job_template": []interface{}{map[string]interface{}{
+				"spec": []interface{}{map[string]interface{}{
+					"template": []interface{}{map[string]interface{}{
+						"spec": []interface{}{map[string]interface{}{
+							"node_selector":  map[string]interface{}{"zone": "a"},
+							"restart_policy": "Never",
+						}},
+					}},
+				}},
+			}},
+		}},
+	}
+
+	v2 := map[string]interface{}{
+		"spec": []interface{}{map[string]interface{}{
+			"job_template": []interface{}{map[string]interface{}{
+				"spec": []interface{}{map[string]interface{}{
+					"template": []interface{}{map[string]interface{}{
+						"spec": []interface{}{map[string]interface{}{
+							"node_selector":              map[string]interface{}{"zone": "a"},
+							"restart_policy":             "Never",
+							"overhead":                   map[string]interface{}{},
+							"preemption_policy":          "",
+							"runtime_class_name":         "",
+							"scheduler_name":             "",
+							"set_hostname_as_fqdn":       false,
+							"topology_spread_constraint": []interface{}{},
+						}},
+					}},
+				}},
+			}},
+		}},
+	}
+
+	actual, _ := upgradeJobTemplatePodSpecWithSchedulingFieldsV1(context.TODO(), v1, nil)
+
+	if !reflect.DeepEqual(v2, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", v2, actual)
+	}
+}
+
+func TestUpgradePodSpecWithSchedulingFieldsV1_keepsValues(t *testing.T) {
+	v1 := map[string]interface{}{
+		"spec": []interface{}{map[string]interface{}{
+			"runtime_class_name": "gvisor",
+		}},
+	}
+
+	actual, _ := upgradePodSpecWithSchedulingFieldsV1(context.TODO(), v1, nil)
+
+	spec := actual["spec"].([]interface{})[0].(map[string]interface{})
+	if spec["runtime_class_name"] != "gvisor" {
+		t.Fatalf("Expected runtime_class_name to be kept, given: %#v", spec["runtime_class_name"])
+	}
+	if spec["scheduler_name"] != "" {
+		t.Fatalf("Expected scheduler_name to be empty, given: %#v", spec["scheduler_name"])
+	}
+}
+
+func TestUpgradeTemplatePodSpecWithSchedulingFieldsV1_empty(t *testing.T) {
+	v1 := map[string]interface{}{
+		"spec": []interface{}{map[string]interface{}{
+			"template": []interface{}{},
+		}},
+	}
+
+	expected := map[string]interface{}{
+		"spec": []interface{}{map[string]interface{}{
+			"template": []interface{}{},
+		}},
+	}
+
+	actual, _ := upgradeTemplatePodSpecWithSchedulingFieldsV1(context.TODO(), v1, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+}
+
+func TestPatchTemplatePodSpecWithSchedulingFieldsV1(t *testing.T) {
+	s := patchTemplatePodSpecWithSchedulingFieldsV1(resourceKubernetesDeploymentSchemaV2())
+
+	podSpec := s["spec"].Elem.(*schema.Resource).Schema["template"].Elem.(*schema.Resource).Schema["spec"].Elem.(*schema.Resource).Schema
+	for k := range podSpecFieldsV2() {
+		if _, ok := podSpec[k]; ok {
+			t.Fatalf("Expected %s to be removed from the V1 pod spec", k)
+		}
+	}
+	if _, ok := podSpec["container"]; !ok {
+		t.Fatal("Expected container to be kept in the V1 pod spec")
+	}
+}
@@ -2,9 +2,11 @@ package kubernetes
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	api "k8s.io/api/autoscaling/v1"
+	v1 "k8s.io/api/core/v1"
 )
 
 func expandHorizontalPodAutoscalerSpec(in []interface{}) (*api.HorizontalPodAutoscalerSpec, error) {
@@ -75,6 +77,28 @@ func flattenCrossVersionObjectReference(ref api.CrossVersionObjectReference) []i
 	return []interface{}{m}
 }
 
+func flattenHorizontalPodAutoscalerStatus(status api.HorizontalPodAutoscalerStatus) []interface{} {
+	m := map[string]interface{}{
+		"current_replicas": int(status.CurrentReplicas),
+		"desired_replicas": int(status.DesiredReplicas),
+		"current_metric":   []interface{}{},
+		"condition":        []interface{}{},
+	}
+	if status.LastScaleTime != nil {
+		m["last_scale_time"] = status.LastScaleTime.Format(time.RFC3339)
+	}
+	if status.CurrentCPUUtilizationPercentage != nil {
+		m["current_metric"] = []interface{}{
+			map[string]interface{}{
+				"type":                "Resource",
+				"name":                string(v1.ResourceCPU),
+				"average_utilization": int(*status.CurrentCPUUtilizationPercentage),
+			},
+		}
+	}
+	return []interface{}{m}
+}
+
 func patchHorizontalPodAutoscalerSpec(prefix string, pathPrefix string, d *schema.ResourceData) []PatchOperation {
 	ops := make([]PatchOperation, 0)
 
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
@@ -33,9 +34,66 @@ func expandHorizontalPodAutoscalerV2Spec(in []interface{}) (*autoscalingv2beta2.
 		spec.Metrics = expandV2Metrics(v)
 	}
 
+	if v, ok := m["behavior"].([]interface{}); ok {
+		spec.Behavior = expandV2HorizontalPodAutoscalerBehavior(v)
+	}
+
 	return spec, nil
 }
 
+func expandV2HorizontalPodAutoscalerBehavior(in []interface{}) *autoscalingv2beta2.HorizontalPodAutoscalerBehavior {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+
+	behavior := &autoscalingv2beta2.HorizontalPodAutoscalerBehavior{}
+	m := in[0].(map[string]interface{})
+
+	if v, ok := m["scale_up"].([]interface{}); ok {
+		behavior.ScaleUp = expandV2ScalingRules(v)
+	}
+
+	if v, ok := m["scale_down"].([]interface{}); ok {
+		behavior.ScaleDown = expandV2ScalingRules(v)
+	}
+
+	return behavior
+}
+
+func expandV2ScalingRules(in []interface{}) *autoscalingv2beta2.HPAScalingRules {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+
+	rules := &autoscalingv2beta2.HPAScalingRules{}
+	m := in[0].(map[string]interface{})
+
+	if v, ok := m["stabilization_window_seconds"].(int); ok {
+		rules.StabilizationWindowSeconds = ptrToInt32(int32(v))
+	}
+
+	if v, ok := m["select_policy"].(string); ok && v != "" {
+		policy := autoscalingv2beta2.ScalingPolicySelect(v)
+		rules.SelectPolicy = &policy
+	}
+
+	if v, ok := m["policy"].([]interface{}); ok {
+		for _, p := range v {
+			policy, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			rules.Policies = append(rules.Policies, autoscalingv2beta2.HPAScalingPolicy{
+				Type:          autoscalingv2beta2.HPAScalingPolicyType(policy["type"].(string)),
+				Value:         int32(policy["value"].(int)),
+				PeriodSeconds: int32(policy["period_seconds"].(int)),
+			})
+		}
+	}
+
+	return rules
+}
+
 func expandV2Metrics(in []interface{}) []autoscalingv2beta2.MetricSpec {
 	metrics := []autoscalingv2beta2.MetricSpec{}
 
@@ -296,9 +354,118 @@ func flattenHorizontalPodAutoscalerV2Spec(spec autoscalingv2beta2.HorizontalPodA
 	}
 	m["metric"] = metrics
 
+	if spec.Behavior != nil {
+		m["behavior"] = flattenV2HorizontalPodAutoscalerBehavior(spec.Behavior)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenV2HorizontalPodAutoscalerBehavior(behavior *autoscalingv2beta2.HorizontalPodAutoscalerBehavior) []interface{} {
+	m := map[string]interface{}{}
+
+	if behavior.ScaleUp != nil {
+		m["scale_up"] = flattenV2ScalingRules(behavior.ScaleUp)
+	}
+
+	if behavior.ScaleDown != nil {
+		m["scale_down"] = flattenV2ScalingRules(behavior.ScaleDown)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenV2ScalingRules(rules *autoscalingv2beta2.HPAScalingRules) []interface{} {
+	m := map[string]interface{}{}
+
+	if rules.StabilizationWindowSeconds != nil {
+		m["stabilization_window_seconds"] = int(*rules.StabilizationWindowSeconds)
+	}
+
+	if rules.SelectPolicy != nil {
+		m["select_policy"] = string(*rules.SelectPolicy)
+	}
+
+	policies := make([]interface{}, 0, len(rules.Policies))
+	for _, p := range rules.Policies {
+		policies = append(policies, map[string]interface{}{
+			"type":           string(p.Type),
+			"value":          int(p.Value),
+			"period_seconds": int(p.PeriodSeconds),
+		})
+	}
+	m["policy"] = policies
+
+	return []interface{}{m}
+}
+
+func flattenHorizontalPodAutoscalerV2Status(status autoscalingv2beta2.HorizontalPodAutoscalerStatus) []interface{} {
+	m := map[string]interface{}{
+		"current_replicas": int(status.CurrentReplicas),
+		"desired_replicas": int(status.DesiredReplicas),
+	}
+
+	if status.LastScaleTime != nil {
+		m["last_scale_time"] = status.LastScaleTime.Format(time.RFC3339)
+	}
+
+	metrics := make([]interface{}, 0, len(status.CurrentMetrics))
+	for _, s := range status.CurrentMetrics {
+		metrics = append(metrics, flattenV2MetricStatus(s))
+	}
+	m["current_metric"] = metrics
+
+	conditions := make([]interface{}, 0, len(status.Conditions))
+	for _, c := range status.Conditions {
+		conditions = append(conditions, map[string]interface{}{
+			"type":                 string(c.Type),
+			"status":               string(c.Status),
+			"reason":               c.Reason,
+			"message":              c.Message,
+			"last_transition_time": c.LastTransitionTime.Format(time.RFC3339),
+		})
+	}
+	m["condition"] = conditions
+
 	return []interface{}{m}
 }
 
+func flattenV2MetricStatus(status autoscalingv2beta2.MetricStatus) map[string]interface{} {
+	m := map[string]interface{}{
+		"type": string(status.Type),
+	}
+
+	var current autoscalingv2beta2.MetricValueStatus
+	switch {
+	case status.Resource != nil:
+		m["name"] = string(status.Resource.Name)
+		current = status.Resource.Current
+	case status.Pods != nil:
+		m["name"] = status.Pods.Metric.Name
+		current = status.Pods.Current
+	case status.Object != nil:
+		m["name"] = status.Object.Metric.Name
+		current = status.Object.Current
+	case status.External != nil:
+		m["name"] = status.External.Metric.Name
+		current = status.External.Current
+	}
+
+	if current.Value != nil {
+		m["value"] = current.Value.String()
+	}
+
+	if current.AverageValue != nil {
+		m["average_value"] = current.AverageValue.String()
+	}
+
+	if current.AverageUtilization != nil {
+		m["average_utilization"] = int(*current.AverageUtilization)
+	}
+
+	return m
+}
+
 func flattenV2CrossVersionObjectReference(ref autoscalingv2beta2.CrossVersionObjectReference) []interface{} {
 	m := make(map[string]interface{}, 0)
 
@@ -348,5 +515,19 @@ func patchHorizontalPodAutoscalerV2Spec(prefix string, pathPrefix string, d *sch
 		})
 	}
 
+	if d.HasChange(prefix + "behavior") {
+		behavior := expandV2HorizontalPodAutoscalerBehavior(d.Get(prefix + "behavior").([]interface{}))
+		if behavior == nil {
+			ops = append(ops, &RemoveOperation{
+				Path: pathPrefix + "/behavior",
+			})
+		} else {
+			ops = append(ops, &AddOperation{
+				Path:  pathPrefix + "/behavior",
+				Value: behavior,
+			})
+		}
+	}
+
 	return ops
 }
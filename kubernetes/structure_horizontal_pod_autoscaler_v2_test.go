@@ -0,0 +1,110 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpandFlattenV2HorizontalPodAutoscalerBehavior(t *testing.T) {
+	minPolicy := autoscalingv2beta2.MinPolicySelect
+	behavior := &autoscalingv2beta2.HorizontalPodAutoscalerBehavior{
+		ScaleDown: &autoscalingv2beta2.HPAScalingRules{
+			StabilizationWindowSeconds: ptrToInt32(0),
+			SelectPolicy:               &minPolicy,
+			Policies: []autoscalingv2beta2.HPAScalingPolicy{
+				{Type: autoscalingv2beta2.PodsScalingPolicy, Value: 1, PeriodSeconds: 60},
+				{Type: autoscalingv2beta2.PercentScalingPolicy, Value: 10, PeriodSeconds: 30},
+			},
+		},
+	}
+
+	flattened := flattenV2HorizontalPodAutoscalerBehavior(behavior)
+	expected := []interface{}{
+		map[string]interface{}{
+			"scale_down": []interface{}{
+				map[string]interface{}{
+					"stabilization_window_seconds": 0,
+					"select_policy":                "Min",
+					"policy": []interface{}{
+						map[string]interface{}{"type": "Pods", "value": 1, "period_seconds": 60},
+						map[string]interface{}{"type": "Percent", "value": 10, "period_seconds": 30},
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Fatalf("Unexpected flattened behavior, expected: %#v\ngiven: %#v", expected, flattened)
+	}
+
+	if out := expandV2HorizontalPodAutoscalerBehavior(flattened); !reflect.DeepEqual(out, behavior) {
+		t.Fatalf("Expected the behavior to survive a round trip, expected: %#v\ngiven: %#v", behavior, out)
+	}
+	if out := expandV2HorizontalPodAutoscalerBehavior([]interface{}{}); out != nil {
+		t.Fatalf("Expected no behavior, given: %#v", out)
+	}
+}
+
+func TestFlattenHorizontalPodAutoscalerV2Status(t *testing.T) {
+	scaled := metav1.NewTime(time.Date(2020, 11, 10, 9, 8, 7, 0, time.UTC))
+	status := autoscalingv2beta2.HorizontalPodAutoscalerStatus{
+		LastScaleTime:   &scaled,
+		CurrentReplicas: 2,
+		DesiredReplicas: 3,
+		CurrentMetrics: []autoscalingv2beta2.MetricStatus{
+			{
+				Type: autoscalingv2beta2.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta2.ResourceMetricStatus{
+					Name:    v1.ResourceCPU,
+					Current: autoscalingv2beta2.MetricValueStatus{AverageUtilization: ptrToInt32(85)},
+				},
+			},
+			{
+				Type: autoscalingv2beta2.ExternalMetricSourceType,
+				External: &autoscalingv2beta2.ExternalMetricStatus{
+					Metric:  autoscalingv2beta2.MetricIdentifier{Name: "queue_size"},
+					Current: autoscalingv2beta2.MetricValueStatus{Value: resource.NewQuantity(12, resource.DecimalSI)},
+				},
+			},
+		},
+		Conditions: []autoscalingv2beta2.HorizontalPodAutoscalerCondition{
+			{
+				Type:               autoscalingv2beta2.AbleToScale,
+				Status:             v1.ConditionTrue,
+				LastTransitionTime: scaled,
+				Reason:             "SucceededRescale",
+				Message:            "the HPA controller was able to update the target scale to 3",
+			},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"current_replicas": 2,
+			"desired_replicas": 3,
+			"last_scale_time":  "2020-11-10T09:08:07Z",
+			"current_metric": []interface{}{
+				map[string]interface{}{"type": "Resource", "name": "cpu", "average_utilization": 85},
+				map[string]interface{}{"type": "External", "name": "queue_size", "value": "12"},
+			},
+			"condition": []interface{}{
+				map[string]interface{}{
+					"type":                 "AbleToScale",
+					"status":               "True",
+					"reason":               "SucceededRescale",
+					"message":              "the HPA controller was able to update the target scale to 3",
+					"last_transition_time": "2020-11-10T09:08:07Z",
+				},
+			},
+		},
+	}
+	if out := flattenHorizontalPodAutoscalerV2Status(status); !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Unexpected flattened status, expected: %#v\ngiven: %#v", expected, out)
+	}
+}
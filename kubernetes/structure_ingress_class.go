@@ -0,0 +1,46 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	networking "k8s.io/api/networking/v1"
+)
+
+// Flatteners
+
+func flattenIngressClassSpec(in networking.IngressClassSpec) []interface{} {
+	att := map[string]interface{}{
+		"controller": in.Controller,
+	}
+	if in.Parameters != nil {
+		att["parameters"] = flattenTypedLocalObjectReference(in.Parameters)
+	}
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandIngressClassSpec(l []interface{}) networking.IngressClassSpec {
+	obj := networking.IngressClassSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["controller"].(string); ok {
+		obj.Controller = v
+	}
+	if v, ok := in["parameters"].([]interface{}); ok && len(v) > 0 {
+		obj.Parameters = expandTypedLocalObjectReference(v)
+	}
+	return obj
+}
+
+// Patch Ops
+
+func patchIngressClassParameters(d *schema.ResourceData) PatchOperations {
+	parameters := expandTypedLocalObjectReference(d.Get("spec.0.parameters").([]interface{}))
+	if parameters == nil {
+		return PatchOperations{&RemoveOperation{Path: "/spec/parameters"}}
+	}
+	return PatchOperations{&AddOperation{Path: "/spec/parameters", Value: parameters}}
+}
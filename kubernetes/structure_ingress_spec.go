@@ -1,14 +1,17 @@
 package kubernetes
 
 import (
+	"strconv"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"k8s.io/api/extensions/v1beta1"
+	networking "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Flatteners
 
-func flattenIngressRule(in []v1beta1.IngressRule) []interface{} {
+func flattenIngressRule(in []networking.IngressRule) []interface{} {
 	att := make([]interface{}, len(in), len(in))
 	for i, r := range in {
 		m := make(map[string]interface{})
@@ -20,7 +23,7 @@ func flattenIngressRule(in []v1beta1.IngressRule) []interface{} {
 	return att
 }
 
-func flattenIngressRuleHttp(in *v1beta1.HTTPIngressRuleValue) []interface{} {
+func flattenIngressRuleHttp(in *networking.HTTPIngressRuleValue) []interface{} {
 	if in == nil {
 		return []interface{}{}
 	}
@@ -30,6 +33,9 @@ func flattenIngressRuleHttp(in *v1beta1.HTTPIngressRuleValue) []interface{} {
 			"path":    p.Path,
 			"backend": flattenIngressBackend(&p.Backend),
 		}
+		if p.PathType != nil {
+			path["path_type"] = string(*p.PathType)
+		}
 		pathAtts[i] = path
 	}
 
@@ -40,27 +46,39 @@ func flattenIngressRuleHttp(in *v1beta1.HTTPIngressRuleValue) []interface{} {
 	return []interface{}{httpAtt}
 }
 
-func flattenIngressBackend(in *v1beta1.IngressBackend) []interface{} {
+func flattenIngressBackend(in *networking.IngressBackend) []interface{} {
 	att := make([]interface{}, 1, 1)
 
 	m := make(map[string]interface{})
-	m["service_name"] = in.ServiceName
-	m["service_port"] = in.ServicePort.String()
+	if in.Service != nil {
+		m["service_name"] = in.Service.Name
+		m["service_port"] = flattenServiceBackendPort(in.Service.Port)
+	}
+	if in.Resource != nil {
+		m["resource"] = flattenTypedLocalObjectReference(in.Resource)
+	}
 
 	att[0] = m
 
 	return att
 }
 
-func flattenIngressSpec(in v1beta1.IngressSpec) []interface{} {
+func flattenServiceBackendPort(in networking.ServiceBackendPort) string {
+	if in.Name != "" {
+		return in.Name
+	}
+	return strconv.Itoa(int(in.Number))
+}
+
+func flattenIngressSpec(in networking.IngressSpec) []interface{} {
 	att := make(map[string]interface{})
 
 	if in.IngressClassName != nil {
 		att["ingress_class_name"] = in.IngressClassName
 	}
 
-	if in.Backend != nil {
-		att["backend"] = flattenIngressBackend(in.Backend)
+	if in.DefaultBackend != nil {
+		att["backend"] = flattenIngressBackend(in.DefaultBackend)
 	}
 
 	if len(in.Rules) > 0 {
@@ -74,7 +92,7 @@ func flattenIngressSpec(in v1beta1.IngressSpec) []interface{} {
 	return []interface{}{att}
 }
 
-func flattenIngressTLS(in []v1beta1.IngressTLS) []interface{} {
+func flattenIngressTLS(in []networking.IngressTLS) []interface{} {
 	att := make([]interface{}, len(in), len(in))
 
 	for i, v := range in {
@@ -90,15 +108,15 @@ func flattenIngressTLS(in []v1beta1.IngressTLS) []interface{} {
 
 // Expanders
 
-func expandIngressRule(l []interface{}) []v1beta1.IngressRule {
+func expandIngressRule(l []interface{}) []networking.IngressRule {
 	if len(l) == 0 || l[0] == nil {
-		return []v1beta1.IngressRule{}
+		return []networking.IngressRule{}
 	}
-	obj := make([]v1beta1.IngressRule, len(l), len(l))
+	obj := make([]networking.IngressRule, len(l), len(l))
 	for i, n := range l {
 		cfg := n.(map[string]interface{})
 
-		var paths []v1beta1.HTTPIngressPath
+		var paths []networking.HTTPIngressPath
 
 		if httpCfg, ok := cfg["http"]; ok {
 			httpList := httpCfg.([]interface{})
@@ -106,12 +124,18 @@ func expandIngressRule(l []interface{}) []v1beta1.IngressRule {
 				http := h.(map[string]interface{})
 				if v, ok := http["path"]; ok {
 					pathList := v.([]interface{})
-					paths = make([]v1beta1.HTTPIngressPath, len(pathList), len(pathList))
+					paths = make([]networking.HTTPIngressPath, len(pathList), len(pathList))
 					for i, path := range pathList {
 						p := path.(map[string]interface{})
-						hip := v1beta1.HTTPIngressPath{
-							Path:    p["path"].(string),
-							Backend: *expandIngressBackend(p["backend"].([]interface{})),
+						// networking.k8s.io/v1 requires a path type, older versions default to this one
+						pathType := networking.PathTypeImplementationSpecific
+						if v, ok := p["path_type"].(string); ok && v != "" {
+							pathType = networking.PathType(v)
+						}
+						hip := networking.HTTPIngressPath{
+							Path:     p["path"].(string),
+							PathType: &pathType,
+							Backend:  *expandIngressBackend(p["backend"].([]interface{})),
 						}
 						paths[i] = hip
 					}
@@ -119,10 +143,10 @@ func expandIngressRule(l []interface{}) []v1beta1.IngressRule {
 			}
 		}
 
-		obj[i] = v1beta1.IngressRule{
+		obj[i] = networking.IngressRule{
 			Host: cfg["host"].(string),
-			IngressRuleValue: v1beta1.IngressRuleValue{
-				HTTP: &v1beta1.HTTPIngressRuleValue{
+			IngressRuleValue: networking.IngressRuleValue{
+				HTTP: &networking.HTTPIngressRuleValue{
 					Paths: paths,
 				},
 			},
@@ -131,19 +155,19 @@ func expandIngressRule(l []interface{}) []v1beta1.IngressRule {
 	return obj
 }
 
-func expandIngressSpec(l []interface{}) v1beta1.IngressSpec {
+func expandIngressSpec(l []interface{}) networking.IngressSpec {
 	if len(l) == 0 || l[0] == nil {
-		return v1beta1.IngressSpec{}
+		return networking.IngressSpec{}
 	}
 	in := l[0].(map[string]interface{})
-	obj := v1beta1.IngressSpec{}
+	obj := networking.IngressSpec{}
 
 	if v, ok := in["ingress_class_name"].(string); ok && len(v) > 0 {
 		obj.IngressClassName = &v
 	}
 
 	if v, ok := in["backend"].([]interface{}); ok && len(v) > 0 {
-		obj.Backend = expandIngressBackend(v)
+		obj.DefaultBackend = expandIngressBackend(v)
 	}
 
 	if v, ok := in["rule"].([]interface{}); ok && len(v) > 0 {
@@ -157,33 +181,45 @@ func expandIngressSpec(l []interface{}) v1beta1.IngressSpec {
 	return obj
 }
 
-func expandIngressBackend(l []interface{}) *v1beta1.IngressBackend {
+func expandIngressBackend(l []interface{}) *networking.IngressBackend {
 	if len(l) == 0 || l[0] == nil {
-		return &v1beta1.IngressBackend{}
+		return &networking.IngressBackend{}
 	}
 	in := l[0].(map[string]interface{})
-	obj := &v1beta1.IngressBackend{}
+	obj := &networking.IngressBackend{}
 
-	if v, ok := in["service_name"].(string); ok {
-		obj.ServiceName = v
+	if v, ok := in["service_name"].(string); ok && v != "" {
+		obj.Service = &networking.IngressServiceBackend{Name: v}
+		if v, ok := in["service_port"].(string); ok {
+			obj.Service.Port = expandServiceBackendPort(v)
+		}
 	}
 
-	if v, ok := in["service_port"].(string); ok {
-		obj.ServicePort = intstr.Parse(v)
+	if v, ok := in["resource"].([]interface{}); ok && len(v) > 0 {
+		obj.Resource = expandTypedLocalObjectReference(v)
 	}
 
 	return obj
 }
 
-func expandIngressTLS(l []interface{}) []v1beta1.IngressTLS {
+// expandServiceBackendPort reads a port number, or a port name when it is not numeric
+func expandServiceBackendPort(s string) networking.ServiceBackendPort {
+	port := intstr.Parse(s)
+	if port.Type == intstr.Int {
+		return networking.ServiceBackendPort{Number: port.IntVal}
+	}
+	return networking.ServiceBackendPort{Name: port.StrVal}
+}
+
+func expandIngressTLS(l []interface{}) []networking.IngressTLS {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
-	tlsList := make([]v1beta1.IngressTLS, len(l), len(l))
+	tlsList := make([]networking.IngressTLS, len(l), len(l))
 	for i, t := range l {
 		in := t.(map[string]interface{})
-		obj := v1beta1.IngressTLS{}
+		obj := networking.IngressTLS{}
 
 		if v, ok := in["hosts"]; ok {
 			obj.Hosts = expandStringSlice(v.([]interface{}))
@@ -198,13 +234,124 @@ func expandIngressTLS(l []interface{}) []v1beta1.IngressTLS {
 	return tlsList
 }
 
+// Conversions for clusters which only serve networking.k8s.io/v1beta1 or extensions/v1beta1,
+// both versions share the same representation.
+
+func ingressToV1beta1(in *networking.Ingress) *networkingv1beta1.Ingress {
+	out := &networkingv1beta1.Ingress{
+		ObjectMeta: in.ObjectMeta,
+		Spec: networkingv1beta1.IngressSpec{
+			IngressClassName: in.Spec.IngressClassName,
+		},
+		Status: networkingv1beta1.IngressStatus{
+			LoadBalancer: in.Status.LoadBalancer,
+		},
+	}
+	if in.Spec.DefaultBackend != nil {
+		out.Spec.Backend = ingressBackendToV1beta1(*in.Spec.DefaultBackend)
+	}
+	for _, t := range in.Spec.TLS {
+		out.Spec.TLS = append(out.Spec.TLS, networkingv1beta1.IngressTLS{
+			Hosts:      t.Hosts,
+			SecretName: t.SecretName,
+		})
+	}
+	for _, r := range in.Spec.Rules {
+		rule := networkingv1beta1.IngressRule{Host: r.Host}
+		if r.HTTP != nil {
+			rule.HTTP = &networkingv1beta1.HTTPIngressRuleValue{}
+			for _, p := range r.HTTP.Paths {
+				path := networkingv1beta1.HTTPIngressPath{
+					Path:    p.Path,
+					Backend: *ingressBackendToV1beta1(p.Backend),
+				}
+				if p.PathType != nil {
+					pathType := networkingv1beta1.PathType(*p.PathType)
+					path.PathType = &pathType
+				}
+				rule.HTTP.Paths = append(rule.HTTP.Paths, path)
+			}
+		}
+		out.Spec.Rules = append(out.Spec.Rules, rule)
+	}
+	return out
+}
+
+func ingressBackendToV1beta1(in networking.IngressBackend) *networkingv1beta1.IngressBackend {
+	out := &networkingv1beta1.IngressBackend{
+		Resource: in.Resource,
+	}
+	if in.Service != nil {
+		out.ServiceName = in.Service.Name
+		if in.Service.Port.Name != "" {
+			out.ServicePort = intstr.FromString(in.Service.Port.Name)
+		} else {
+			out.ServicePort = intstr.FromInt(int(in.Service.Port.Number))
+		}
+	}
+	return out
+}
+
+func ingressFromV1beta1(in *networkingv1beta1.Ingress) *networking.Ingress {
+	out := &networking.Ingress{
+		ObjectMeta: in.ObjectMeta,
+		Spec: networking.IngressSpec{
+			IngressClassName: in.Spec.IngressClassName,
+		},
+		Status: networking.IngressStatus{
+			LoadBalancer: in.Status.LoadBalancer,
+		},
+	}
+	if in.Spec.Backend != nil {
+		out.Spec.DefaultBackend = ingressBackendFromV1beta1(*in.Spec.Backend)
+	}
+	for _, t := range in.Spec.TLS {
+		out.Spec.TLS = append(out.Spec.TLS, networking.IngressTLS{
+			Hosts:      t.Hosts,
+			SecretName: t.SecretName,
+		})
+	}
+	for _, r := range in.Spec.Rules {
+		rule := networking.IngressRule{Host: r.Host}
+		if r.HTTP != nil {
+			rule.HTTP = &networking.HTTPIngressRuleValue{}
+			for _, p := range r.HTTP.Paths {
+				path := networking.HTTPIngressPath{
+					Path:    p.Path,
+					Backend: *ingressBackendFromV1beta1(p.Backend),
+				}
+				if p.PathType != nil {
+					pathType := networking.PathType(*p.PathType)
+					path.PathType = &pathType
+				}
+				rule.HTTP.Paths = append(rule.HTTP.Paths, path)
+			}
+		}
+		out.Spec.Rules = append(out.Spec.Rules, rule)
+	}
+	return out
+}
+
+func ingressBackendFromV1beta1(in networkingv1beta1.IngressBackend) *networking.IngressBackend {
+	out := &networking.IngressBackend{
+		Resource: in.Resource,
+	}
+	if in.ServiceName != "" {
+		out.Service = &networking.IngressServiceBackend{
+			Name: in.ServiceName,
+			Port: expandServiceBackendPort(in.ServicePort.String()),
+		}
+	}
+	return out
+}
+
 // Patch Ops
 
 func patchIngressSpec(keyPrefix, pathPrefix string, d *schema.ResourceData) PatchOperations {
 	ops := make([]PatchOperation, 0, 0)
 	if d.HasChange(keyPrefix + "backend") {
 		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "backend",
+			Path:  pathPrefix + "defaultBackend",
 			Value: expandIngressBackend(d.Get(keyPrefix + "backend").([]interface{})),
 		})
 	}
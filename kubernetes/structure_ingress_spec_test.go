@@ -4,35 +4,42 @@ import (
 	"reflect"
 	"testing"
 
-	"k8s.io/api/extensions/v1beta1"
+	api "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Test Flatteners
 func TestFlattenIngressRule(t *testing.T) {
-	r := v1beta1.HTTPIngressRuleValue{
-		Paths: []v1beta1.HTTPIngressPath{
+	pathType := networking.PathTypePrefix
+	r := networking.HTTPIngressRuleValue{
+		Paths: []networking.HTTPIngressPath{
 			{
-				Path: "/foo/bar",
-				Backend: v1beta1.IngressBackend{
-					ServiceName: "foo",
-					ServicePort: intstr.FromInt(1234),
+				Path:     "/foo/bar",
+				PathType: &pathType,
+				Backend: networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{
+						Name: "foo",
+						Port: networking.ServiceBackendPort{Number: 1234},
+					},
 				},
 			},
 		},
 	}
 
-	in := []v1beta1.IngressRule{
+	in := []networking.IngressRule{
 		{
 			Host: "the-app-name.staging.live.domain-replaced.tld",
-			IngressRuleValue: v1beta1.IngressRuleValue{
-				HTTP: (*v1beta1.HTTPIngressRuleValue)(nil),
+			IngressRuleValue: networking.IngressRuleValue{
+				HTTP: (*networking.HTTPIngressRuleValue)(nil),
 			},
 		},
 		{
 			Host: "",
-			IngressRuleValue: v1beta1.IngressRuleValue{
-				HTTP: (*v1beta1.HTTPIngressRuleValue)(&r),
+			IngressRuleValue: networking.IngressRuleValue{
+				HTTP: (*networking.HTTPIngressRuleValue)(&r),
 			},
 		},
 	}
@@ -47,7 +54,8 @@ func TestFlattenIngressRule(t *testing.T) {
 				map[string]interface{}{
 					"path": []interface{}{
 						map[string]interface{}{
-							"path": "/foo/bar",
+							"path":      "/foo/bar",
+							"path_type": "Prefix",
 							"backend": []interface{}{
 								map[string]interface{}{
 									"service_name": "foo",
@@ -76,3 +84,83 @@ func TestFlattenIngressRule(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandServiceBackendPort(t *testing.T) {
+	cases := map[string]networking.ServiceBackendPort{
+		"80":    {Number: 80},
+		"https": {Name: "https"},
+	}
+	for in, expected := range cases {
+		out := expandServiceBackendPort(in)
+		if out != expected {
+			t.Errorf("Unexpected port for %q, expected: %#v, given: %#v", in, expected, out)
+		}
+		if flattenServiceBackendPort(out) != in {
+			t.Errorf("Unexpected flattened port for %q, given: %q", in, flattenServiceBackendPort(out))
+		}
+	}
+}
+
+func TestIngressV1beta1Conversion(t *testing.T) {
+	className := "nginx"
+	apiGroup := "k8s.example.com"
+	pathType := networking.PathTypeExact
+	in := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		Spec: networking.IngressSpec{
+			IngressClassName: &className,
+			DefaultBackend: &networking.IngressBackend{
+				Resource: &api.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "StorageBucket", Name: "static"},
+			},
+			TLS: []networking.IngressTLS{{Hosts: []string{"example.com"}, SecretName: "tls"}},
+			Rules: []networking.IngressRule{
+				{
+					Host: "example.com",
+					IngressRuleValue: networking.IngressRuleValue{
+						HTTP: &networking.HTTPIngressRuleValue{
+							Paths: []networking.HTTPIngressPath{
+								{
+									Path:     "/api",
+									PathType: &pathType,
+									Backend: networking.IngressBackend{
+										Service: &networking.IngressServiceBackend{
+											Name: "api",
+											Port: networking.ServiceBackendPort{Name: "http"},
+										},
+									},
+								},
+								{
+									Path:     "/",
+									PathType: &pathType,
+									Backend: networking.IngressBackend{
+										Service: &networking.IngressServiceBackend{
+											Name: "web",
+											Port: networking.ServiceBackendPort{Number: 8080},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	out := ingressToV1beta1(in)
+	backend := out.Spec.Rules[0].HTTP.Paths[1].Backend
+	if backend.ServiceName != "web" || backend.ServicePort != intstr.FromInt(8080) {
+		t.Fatalf("Unexpected v1beta1 backend: %#v", backend)
+	}
+	if *out.Spec.Rules[0].HTTP.Paths[0].PathType != networkingv1beta1.PathTypeExact {
+		t.Fatalf("Unexpected v1beta1 path type: %v", *out.Spec.Rules[0].HTTP.Paths[0].PathType)
+	}
+	if out.Spec.Backend == nil || out.Spec.Backend.Resource.Name != "static" {
+		t.Fatalf("Unexpected v1beta1 default backend: %#v", out.Spec.Backend)
+	}
+
+	back := ingressFromV1beta1(out)
+	if !reflect.DeepEqual(in, back) {
+		t.Fatalf("Ingress changed by the conversion to v1beta1 and back:\n\tWant: %#v\n\tGot: %#v", in, back)
+	}
+}
@@ -0,0 +1,208 @@
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Fields populated by the API server which are never part of a manifest
+var manifestServerSideFields = []string{
+	"creationTimestamp",
+	"deletionGracePeriodSeconds",
+	"deletionTimestamp",
+	"generation",
+	"managedFields",
+	"resourceVersion",
+	"selfLink",
+	"uid",
+}
+
+func buildManifestId(apiVersion, kind, namespace, name string) string {
+	return strings.Join([]string{apiVersion, kind, namespace, name}, ",")
+}
+
+func manifestIdParts(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, ",")
+	switch len(parts) {
+	case 3:
+		return parts[0], parts[1], "", parts[2], nil
+	case 4:
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+	err := fmt.Errorf("Unexpected ID format (%q), expected %q.", id, "apiVersion,kind,namespace,name")
+	return "", "", "", "", err
+}
+
+// decodeManifestValue decodes the JSON value of a top-level field of a manifest.
+// Numbers are kept as json.Number, in the canonical form of normalizeManifestNumber.
+func decodeManifestValue(value string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(value))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return normalizeManifestNumbers(v), nil
+}
+
+func encodeManifestValue(v interface{}) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func normalizeManifestValue(value string) (string, error) {
+	v, err := decodeManifestValue(value)
+	if err != nil {
+		return "", err
+	}
+	return encodeManifestValue(v)
+}
+
+// normalizeManifestNumber formats numbers which are equal the same way, e.g. 1.0 as 1
+func normalizeManifestNumber(n json.Number) json.Number {
+	if i, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+		return json.Number(strconv.FormatInt(i, 10))
+	}
+	f, err := strconv.ParseFloat(string(n), 64)
+	if err != nil {
+		return n
+	}
+	return json.Number(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+func normalizeManifestNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = normalizeManifestNumbers(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = normalizeManifestNumbers(e)
+		}
+	case json.Number:
+		return normalizeManifestNumber(v)
+	}
+	return v
+}
+
+// decodeManifest decodes the top-level fields of a manifest, each of them encoded as JSON.
+func decodeManifest(manifest map[string]interface{}) (map[string]interface{}, error) {
+	m := make(map[string]interface{}, len(manifest))
+	for k, v := range manifest {
+		s, _ := v.(string)
+		value, err := decodeManifestValue(s)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to decode %q of the manifest: %s", k, err)
+		}
+		m[k] = value
+	}
+	return m, nil
+}
+
+// encodeManifest encodes each top-level field of m as JSON.
+func encodeManifest(m map[string]interface{}) (map[string]interface{}, error) {
+	manifest := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		value, err := encodeManifestValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to encode %q of the manifest: %s", k, err)
+		}
+		manifest[k] = value
+	}
+	return manifest, nil
+}
+
+func expandManifest(manifest map[string]interface{}) (*unstructured.Unstructured, error) {
+	m, err := decodeManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{Object: m}
+	if obj.GetAPIVersion() == "" {
+		return nil, fmt.Errorf("Manifest is missing %q", "apiVersion")
+	}
+	if obj.GetKind() == "" {
+		return nil, fmt.Errorf("Manifest is missing %q", "kind")
+	}
+	if obj.GetName() == "" {
+		return nil, fmt.Errorf("Manifest is missing %q", "metadata.name")
+	}
+	return obj, nil
+}
+
+func manifestGroupVersionKind(obj *unstructured.Unstructured) (apimachineryschema.GroupVersionKind, error) {
+	gv, err := apimachineryschema.ParseGroupVersion(obj.GetAPIVersion())
+	if err != nil {
+		return apimachineryschema.GroupVersionKind{}, err
+	}
+	return gv.WithKind(obj.GetKind()), nil
+}
+
+// flattenManifest returns the subset of the live object which is declared
+// in the manifest, so only fields owned by Terraform are compared on refresh.
+// With no manifest (e.g. on import) the whole object is returned,
+// excluding the fields populated by the API server.
+func flattenManifest(live map[string]interface{}, manifest map[string]interface{}) map[string]interface{} {
+	if manifest == nil {
+		out := projectManifestValue(live, nil).(map[string]interface{})
+		delete(out, "status")
+		if md, ok := out["metadata"].(map[string]interface{}); ok {
+			for _, f := range manifestServerSideFields {
+				delete(md, f)
+			}
+		}
+		return out
+	}
+	return projectManifestValue(live, manifest).(map[string]interface{})
+}
+
+func projectManifestValue(live, manifest interface{}) interface{} {
+	switch l := live.(type) {
+	case map[string]interface{}:
+		m, ok := manifest.(map[string]interface{})
+		out := make(map[string]interface{})
+		if !ok {
+			for k, v := range l {
+				out[k] = projectManifestValue(v, nil)
+			}
+			return out
+		}
+		for k, mv := range m {
+			if lv, ok := l[k]; ok {
+				out[k] = projectManifestValue(lv, mv)
+			}
+		}
+		return out
+	case []interface{}:
+		m, _ := manifest.([]interface{})
+		out := make([]interface{}, len(l))
+		for i, lv := range l {
+			var mv interface{}
+			if i < len(m) {
+				mv = m[i]
+			}
+			out[i] = projectManifestValue(lv, mv)
+		}
+		return out
+	case float64:
+		return normalizeManifestNumber(json.Number(strconv.FormatFloat(l, 'f', -1, 64)))
+	case int64:
+		return json.Number(strconv.FormatInt(l, 10))
+	case json.Number:
+		return normalizeManifestNumber(l)
+	}
+	return live
+}
@@ -0,0 +1,224 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestManifestIdParts(t *testing.T) {
+	testCases := []struct {
+		Id          string
+		APIVersion  string
+		Kind        string
+		Namespace   string
+		Name        string
+		ExpectError bool
+	}{
+		{"v1,ConfigMap,default,test", "v1", "ConfigMap", "default", "test", false},
+		{"cert-manager.io/v1,Certificate,istio-system,ingress-cert", "cert-manager.io/v1", "Certificate", "istio-system", "ingress-cert", false},
+		{"rbac.authorization.k8s.io/v1,ClusterRole,,admin", "rbac.authorization.k8s.io/v1", "ClusterRole", "", "admin", false},
+		{"v1,Namespace,test", "v1", "Namespace", "", "test", false},
+		{"default/test", "", "", "", "", true},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			apiVersion, kind, namespace, name, err := manifestIdParts(tc.Id)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatalf("Expected %q to be rejected", tc.Id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if apiVersion != tc.APIVersion || kind != tc.Kind || namespace != tc.Namespace || name != tc.Name {
+				t.Fatalf("Unexpected parts of %q: %q %q %q %q", tc.Id, apiVersion, kind, namespace, name)
+			}
+		})
+	}
+}
+
+func TestFlattenManifest(t *testing.T) {
+	live := `{
+		"apiVersion": "v1",
+		"kind": "ConfigMap",
+		"metadata": {
+			"name": "test",
+			"namespace": "default",
+			"uid": "2d5d3a2c-9b1c-4c4c-8b8b-6d3c7e1d0f5a",
+			"resourceVersion": "1234",
+			"annotations": {"owner": "someone-else", "team": "infra"}
+		},
+		"data": {"one": "1", "two": "2"},
+		"spec": {"ports": [{"port": 80, "protocol": "TCP"}], "replicas": 1000000}
+	}`
+
+	testCases := []struct {
+		Manifest string
+		Expected string
+	}{
+		{
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test","annotations":{"team":"infra"}},"data":{"one":"1"}}`,
+			`{"apiVersion":"v1","data":{"one":"1"},"kind":"ConfigMap","metadata":{"annotations":{"team":"infra"},"name":"test"}}`,
+		},
+		{
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"},"spec":{"ports":[{"port":80}],"replicas":1000000}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"},"spec":{"ports":[{"port":80}],"replicas":1000000}}`,
+		},
+		{
+			"",
+			`{"apiVersion":"v1","data":{"one":"1","two":"2"},"kind":"ConfigMap","metadata":{"annotations":{"owner":"someone-else","team":"infra"},"name":"test","namespace":"default"},"spec":{"ports":[{"port":80,"protocol":"TCP"}],"replicas":1000000}}`,
+		},
+	}
+
+	l, err := decodeManifestValue(live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			var m map[string]interface{}
+			if tc.Manifest != "" {
+				v, err := decodeManifestValue(tc.Manifest)
+				if err != nil {
+					t.Fatal(err)
+				}
+				m = v.(map[string]interface{})
+			}
+			out, err := encodeManifestValue(flattenManifest(l.(map[string]interface{}), m))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != tc.Expected {
+				t.Fatalf("Unexpected output.\nExpected: %s\nGiven:    %s", tc.Expected, out)
+			}
+		})
+	}
+}
+
+func TestNormalizeManifestValue(t *testing.T) {
+	testCases := []struct {
+		Value    string
+		Expected string
+	}{
+		{`1`, `1`},
+		{`1.0`, `1`},
+		{`1.50`, `1.5`},
+		{`1e3`, `1000`},
+		{`{"spec": {"replicas": 3.0, "ratio": 0.25}}`, `{"spec":{"ratio":0.25,"replicas":3}}`},
+		{`[{"port": 80.0}, "a<b"]`, `[{"port":80},"a<b"]`},
+		{`9007199254740993`, `9007199254740993`},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			out, err := normalizeManifestValue(tc.Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != tc.Expected {
+				t.Fatalf("Unexpected output.\nExpected: %s\nGiven:    %s", tc.Expected, out)
+			}
+		})
+	}
+}
+
+func TestExpandManifest(t *testing.T) {
+	testCases := []struct {
+		Manifest    map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			map[string]interface{}{
+				"apiVersion": `"v1"`,
+				"kind":       `"ConfigMap"`,
+				"metadata":   `{"name":"test"}`,
+				"data":       `{"one":"1"}`,
+			},
+			false,
+		},
+		{
+			map[string]interface{}{
+				"apiVersion": `v1`,
+				"kind":       `"ConfigMap"`,
+				"metadata":   `{"name":"test"}`,
+			},
+			true,
+		},
+		{
+			map[string]interface{}{
+				"apiVersion": `"v1"`,
+				"kind":       `"ConfigMap"`,
+				"metadata":   `{}`,
+			},
+			true,
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			obj, err := expandManifest(tc.Manifest)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatalf("Expected %#v to be rejected", tc.Manifest)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if obj.GetAPIVersion() != "v1" || obj.GetKind() != "ConfigMap" || obj.GetName() != "test" {
+				t.Fatalf("Unexpected object: %#v", obj.Object)
+			}
+		})
+	}
+}
+
+func TestResourceKubernetesManifestDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "apps/v1,Deployment,default,test",
+		Attributes: map[string]string{
+			"id":                  "apps/v1,Deployment,default,test",
+			"manifest.%":          "4",
+			"manifest.apiVersion": `"apps/v1"`,
+			"manifest.kind":       `"Deployment"`,
+			"manifest.metadata":   `{"name":"test","namespace":"default"}`,
+			"manifest.spec":       `{"replicas":1,"paused":false}`,
+		},
+	}
+
+	testCases := []struct {
+		Spec         string
+		ExpectedDiff []string
+	}{
+		{`{"paused": false, "replicas": 1.0}`, nil},
+		{`{"paused":false,"replicas":2}`, []string{"manifest.spec"}},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"manifest": map[string]interface{}{
+					"apiVersion": `"apps/v1"`,
+					"kind":       `"Deployment"`,
+					"metadata":   `{"name":"test","namespace":"default"}`,
+					"spec":       tc.Spec,
+				},
+			})
+			diff, err := resourceKubernetesManifest().Diff(context.Background(), state, config, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changed := []string{}
+			if diff != nil {
+				for k := range diff.Attributes {
+					changed = append(changed, k)
+				}
+			}
+			if len(changed) != len(tc.ExpectedDiff) || len(changed) > 0 && changed[0] != tc.ExpectedDiff[0] {
+				t.Fatalf("Expected changes of %q, got %q", tc.ExpectedDiff, changed)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package kubernetes
+
+import (
+	node "k8s.io/api/node/v1beta1"
+)
+
+// Flatteners
+
+func flattenRuntimeClassOverhead(in *node.Overhead) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	att := map[string]interface{}{
+		"pod_fixed": flattenResourceList(in.PodFixed),
+	}
+	return []interface{}{att}
+}
+
+func flattenRuntimeClassScheduling(in *node.Scheduling) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	att := map[string]interface{}{}
+	if len(in.NodeSelector) > 0 {
+		att["node_selector"] = in.NodeSelector
+	}
+	if len(in.Tolerations) > 0 {
+		att["toleration"] = flattenTolerations(in.Tolerations)
+	}
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandRuntimeClassOverhead(l []interface{}) (*node.Overhead, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	in := l[0].(map[string]interface{})
+	podFixed, err := expandMapToResourceList(in["pod_fixed"].(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &node.Overhead{PodFixed: *podFixed}, nil
+}
+
+func expandRuntimeClassScheduling(l []interface{}) (*node.Scheduling, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &node.Scheduling{}
+
+	if v, ok := in["node_selector"].(map[string]interface{}); ok && len(v) > 0 {
+		obj.NodeSelector = expandStringMap(v)
+	}
+	if v, ok := in["toleration"].([]interface{}); ok && len(v) > 0 {
+		tolerations, err := expandTolerations(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tolerations {
+			obj.Tolerations = append(obj.Tolerations, *t)
+		}
+	}
+	return obj, nil
+}
+
+// Patch Ops
+
+func patchRuntimeClassField(path string, value interface{}, remove bool) PatchOperation {
+	if remove {
+		return &RemoveOperation{Path: path}
+	}
+	return &AddOperation{Path: path, Value: value}
+}
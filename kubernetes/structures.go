@@ -4,12 +4,14 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func idParts(id string) (string, string, error) {
@@ -51,22 +53,192 @@ func expandMetadata(in []interface{}) metav1.ObjectMeta {
 		meta.Namespace = v.(string)
 	}
 
+	if v, ok := m["finalizers"].([]interface{}); ok && len(v) > 0 {
+		meta.Finalizers = expandStringSlice(v)
+	}
+
+	if v, ok := m["owner_references"].([]interface{}); ok && len(v) > 0 {
+		meta.OwnerReferences = expandOwnerReferences(v)
+	}
+
 	return meta
 }
 
-func patchMetadata(keyPrefix, pathPrefix string, d *schema.ResourceData) PatchOperations {
+func expandOwnerReferences(in []interface{}) []metav1.OwnerReference {
+	refs := make([]metav1.OwnerReference, 0, len(in))
+	for _, v := range in {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ref := metav1.OwnerReference{
+			APIVersion: m["api_version"].(string),
+			Kind:       m["kind"].(string),
+			Name:       m["name"].(string),
+			UID:        types.UID(m["uid"].(string)),
+		}
+		if v, ok := m["block_owner_deletion"].(bool); ok && v {
+			ref.BlockOwnerDeletion = ptrToBool(v)
+		}
+		if v, ok := m["controller"].(bool); ok && v {
+			ref.Controller = ptrToBool(v)
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+func flattenOwnerReferences(in []metav1.OwnerReference) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		m := map[string]interface{}{
+			"api_version": v.APIVersion,
+			"kind":        v.Kind,
+			"name":        v.Name,
+			"uid":         string(v.UID),
+		}
+		if v.BlockOwnerDeletion != nil {
+			m["block_owner_deletion"] = *v.BlockOwnerDeletion
+		}
+		if v.Controller != nil {
+			m["controller"] = *v.Controller
+		}
+		att[i] = m
+	}
+	return att
+}
+
+// isInternalFinalizer reports whether a finalizer is added and removed by Kubernetes itself,
+// like the ones protecting volumes or the garbage collector's deletion propagation.
+func isInternalFinalizer(finalizer string) bool {
+	switch finalizer {
+	case metav1.FinalizerOrphanDependents, metav1.FinalizerDeleteDependents:
+		return true
+	}
+	return isInternalKey(finalizer)
+}
+
+// mergeInternalFinalizers returns the configured finalizers followed by the
+// internal ones of the object which are not configured.
+func mergeInternalFinalizers(current, configured []interface{}) []string {
+	result := expandStringSlice(configured)
+	for _, v := range current {
+		f := v.(string)
+		if isInternalFinalizer(f) && !isValueInList(f, configured) {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// suppressInternalFinalizersDiff hides the internal finalizers of an object which are not configured.
+func suppressInternalFinalizersDiff(k, old, new string, d *schema.ResourceData) bool {
+	key := k[:strings.LastIndex(k, "finalizers")+len("finalizers")]
+	o, n := d.GetChange(key)
+	current := o.([]interface{})
+	configured := n.([]interface{})
+	merged := mergeInternalFinalizers(current, configured)
+	if len(merged) != len(current) {
+		return false
+	}
+	for i, f := range merged {
+		if current[i].(string) != f {
+			return false
+		}
+	}
+	return true
+}
+
+func isValueInList(value string, l []interface{}) bool {
+	for _, v := range l {
+		if v.(string) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// patchMetadata returns the operations updating the metadata of an object.
+// The state hides the internal annotations and labels, and those matching the provider's
+// ignore_annotations and ignore_labels patterns, so an empty map in the state does not
+// mean the object has none. Their keys are then patched one by one, and current is only
+// called to find out whether the map exists when the first configured key is added.
+func patchMetadata(keyPrefix, pathPrefix string, d *schema.ResourceData, providerMetadata interface{}, current func() (metav1.Object, error)) (PatchOperations, error) {
 	ops := make([]PatchOperation, 0, 0)
+	var live metav1.Object
+	liveMetadata := func() (metav1.Object, error) {
+		if live != nil {
+			return live, nil
+		}
+		obj, err := current()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read the current metadata: %s", err)
+		}
+		live = obj
+		return live, nil
+	}
 	if d.HasChange(keyPrefix + "annotations") {
 		oldV, newV := d.GetChange(keyPrefix + "annotations")
-		diffOps := diffStringMap(pathPrefix+"annotations", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		diffOps, err := patchMetadataMap(pathPrefix+"annotations", oldV.(map[string]interface{}), newV.(map[string]interface{}), func() (bool, error) {
+			obj, err := liveMetadata()
+			if err != nil {
+				return false, err
+			}
+			return len(obj.GetAnnotations()) > 0, nil
+		})
+		if err != nil {
+			return nil, err
+		}
 		ops = append(ops, diffOps...)
 	}
 	if d.HasChange(keyPrefix + "labels") {
 		oldV, newV := d.GetChange(keyPrefix + "labels")
-		diffOps := diffStringMap(pathPrefix+"labels", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		diffOps, err := patchMetadataMap(pathPrefix+"labels", oldV.(map[string]interface{}), newV.(map[string]interface{}), func() (bool, error) {
+			obj, err := liveMetadata()
+			if err != nil {
+				return false, err
+			}
+			return len(obj.GetLabels()) > 0, nil
+		})
+		if err != nil {
+			return nil, err
+		}
 		ops = append(ops, diffOps...)
 	}
-	return ops
+	if d.HasChange(keyPrefix + "finalizers") {
+		oldV, newV := d.GetChange(keyPrefix + "finalizers")
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "finalizers",
+			Value: mergeInternalFinalizers(oldV.([]interface{}), newV.([]interface{})),
+		})
+	}
+	if d.HasChange(keyPrefix + "owner_references") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "ownerReferences",
+			Value: expandOwnerReferences(d.Get(keyPrefix + "owner_references").([]interface{})),
+		})
+	}
+	return ops, nil
+}
+
+// patchMetadataMap patches the configured keys of an annotations or labels map,
+// leaving the keys hidden from the state in place. The map is only created
+// when the object has none yet.
+func patchMetadataMap(pathPrefix string, oldV, newV map[string]interface{}, exists func() (bool, error)) (PatchOperations, error) {
+	ops := make([]PatchOperation, 0, 0)
+	if len(oldV) == 0 && len(newV) > 0 {
+		ok, err := exists()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			ops = append(ops, &AddOperation{
+				Path:  strings.TrimRight(pathPrefix, "/"),
+				Value: map[string]interface{}{},
+			})
+		}
+	}
+	return append(ops, diffStringMapKeys(pathPrefix, oldV, newV)...), nil
 }
 
 func expandStringMap(m map[string]interface{}) map[string]string {
@@ -109,23 +281,44 @@ func expandStringSlice(s []interface{}) []string {
 	return result
 }
 
-func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, metaPrefix ...string) []interface{} {
+func expandRegexpList(l []interface{}) ([]*regexp.Regexp, error) {
+	result := make([]*regexp.Regexp, 0, len(l))
+	for _, v := range l {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, providerMetadata interface{}, metaPrefix ...string) []interface{} {
+	ignoreAnnotations, ignoreLabels := ignoredMetadataKeys(providerMetadata)
 	m := make(map[string]interface{})
 	prefix := ""
 	if len(metaPrefix) > 0 {
 		prefix = metaPrefix[0]
 	}
 	configAnnotations := d.Get(prefix + "metadata.0.annotations").(map[string]interface{})
-	m["annotations"] = removeInternalKeys(meta.Annotations, configAnnotations)
+	annotations := removeInternalKeys(meta.Annotations, configAnnotations)
+	m["annotations"] = removeIgnoredKeys(annotations, configAnnotations, ignoreAnnotations)
 	if meta.GenerateName != "" {
 		m["generate_name"] = meta.GenerateName
 	}
 	configLabels := d.Get(prefix + "metadata.0.labels").(map[string]interface{})
-	m["labels"] = removeInternalKeys(meta.Labels, configLabels)
+	labels := removeInternalKeys(meta.Labels, configLabels)
+	m["labels"] = removeIgnoredKeys(labels, configLabels, ignoreLabels)
 	m["name"] = meta.Name
 	m["resource_version"] = meta.ResourceVersion
 	m["uid"] = fmt.Sprintf("%v", meta.UID)
 	m["generation"] = meta.Generation
+	if len(meta.Finalizers) > 0 {
+		m["finalizers"] = meta.Finalizers
+	}
+	if len(meta.OwnerReferences) > 0 {
+		m["owner_references"] = flattenOwnerReferences(meta.OwnerReferences)
+	}
 
 	if meta.Namespace != "" {
 		m["namespace"] = meta.Namespace
@@ -134,6 +327,16 @@ func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, metaPrefix
 	return []interface{}{m}
 }
 
+// flattenTemplateMetadata flattens the metadata of an object template, whose
+// schema has no finalizers or owner references.
+func flattenTemplateMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, providerMetadata interface{}, metaPrefix ...string) []interface{} {
+	l := flattenMetadata(meta, d, providerMetadata, metaPrefix...)
+	m := l[0].(map[string]interface{})
+	delete(m, "finalizers")
+	delete(m, "owner_references")
+	return l
+}
+
 func removeInternalKeys(m map[string]string, d map[string]interface{}) map[string]string {
 	for k := range m {
 		if isInternalKey(k) && !isKeyInMap(k, d) {
@@ -143,6 +346,33 @@ func removeInternalKeys(m map[string]string, d map[string]interface{}) map[strin
 	return m
 }
 
+func removeIgnoredKeys(m map[string]string, d map[string]interface{}, patterns []*regexp.Regexp) map[string]string {
+	for k := range m {
+		if isIgnoredKey(k, patterns) && !isKeyInMap(k, d) {
+			delete(m, k)
+		}
+	}
+	return m
+}
+
+func isIgnoredKey(key string, patterns []*regexp.Regexp) bool {
+	for _, p := range patterns {
+		if p.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// ignoredMetadataKeys returns the provider's ignore_annotations and ignore_labels patterns
+func ignoredMetadataKeys(providerMetadata interface{}) ([]*regexp.Regexp, []*regexp.Regexp) {
+	m, ok := providerMetadata.(KubeClientsets)
+	if !ok {
+		return nil, nil
+	}
+	return m.IgnoreAnnotations(), m.IgnoreLabels()
+}
+
 func isKeyInMap(key string, d map[string]interface{}) bool {
 	if d == nil {
 		return false
@@ -506,6 +736,32 @@ func expandLocalObjectReferenceArray(in []interface{}) []api.LocalObjectReferenc
 	return att
 }
 
+func flattenTypedLocalObjectReference(in *api.TypedLocalObjectReference) []interface{} {
+	m := map[string]interface{}{
+		"kind": in.Kind,
+		"name": in.Name,
+	}
+	if in.APIGroup != nil {
+		m["api_group"] = *in.APIGroup
+	}
+	return []interface{}{m}
+}
+
+func expandTypedLocalObjectReference(l []interface{}) *api.TypedLocalObjectReference {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &api.TypedLocalObjectReference{
+		Kind: in["kind"].(string),
+		Name: in["name"].(string),
+	}
+	if v, ok := in["api_group"].(string); ok && v != "" {
+		obj.APIGroup = &v
+	}
+	return obj
+}
+
 func flattenServiceAccountSecrets(in []api.ObjectReference, defaultSecretName string) []interface{} {
 	att := make([]interface{}, 0)
 	for _, v := range in {
@@ -1,27 +1,141 @@
 package kubernetes
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"k8s.io/api/certificates/v1beta1"
+	certificates "k8s.io/api/certificates/v1"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
 )
 
-func expandCertificateSigningRequestSpec(csr []interface{}) (*v1beta1.CertificateSigningRequestSpec, error) {
-	obj := &v1beta1.CertificateSigningRequestSpec{}
+func expandCertificateSigningRequestSpec(csr []interface{}) (*certificates.CertificateSigningRequestSpec, error) {
+	obj := &certificates.CertificateSigningRequestSpec{}
 	if len(csr) == 0 || csr[0] == nil {
 		return obj, nil
 	}
 	in := csr[0].(map[string]interface{})
 	obj.Request = []byte(in["request"].(string))
+	if v, ok := in["signer_name"].(string); ok && v != "" {
+		obj.SignerName = v
+	}
 	if v, ok := in["usages"].(*schema.Set); ok && v.Len() > 0 {
 		obj.Usages = expandCertificateSigningRequestUsages(v.List())
 	}
 	return obj, nil
 }
 
-func expandCertificateSigningRequestUsages(s []interface{}) []v1beta1.KeyUsage {
-	out := make([]v1beta1.KeyUsage, len(s), len(s))
+func expandCertificateSigningRequestUsages(s []interface{}) []certificates.KeyUsage {
+	out := make([]certificates.KeyUsage, len(s), len(s))
 	for i, v := range s {
-		out[i] = v1beta1.KeyUsage(v.(string))
+		out[i] = certificates.KeyUsage(v.(string))
+	}
+	return out
+}
+
+func flattenCertificateSigningRequestSpec(in certificates.CertificateSigningRequestSpec) []interface{} {
+	att := make(map[string]interface{})
+	att["request"] = string(in.Request)
+	att["signer_name"] = in.SignerName
+	usages := make([]interface{}, len(in.Usages), len(in.Usages))
+	for i, v := range in.Usages {
+		usages[i] = string(v)
+	}
+	att["usages"] = schema.NewSet(schema.HashString, usages)
+	return []interface{}{att}
+}
+
+// Conversions for clusters which only serve certificates.k8s.io/v1beta1
+
+func certificateSigningRequestToV1beta1(in *certificates.CertificateSigningRequest) *certificatesv1beta1.CertificateSigningRequest {
+	out := &certificatesv1beta1.CertificateSigningRequest{
+		ObjectMeta: in.ObjectMeta,
+		Spec: certificatesv1beta1.CertificateSigningRequestSpec{
+			Request:  in.Spec.Request,
+			Username: in.Spec.Username,
+			UID:      in.Spec.UID,
+			Groups:   in.Spec.Groups,
+		},
+		Status: certificatesv1beta1.CertificateSigningRequestStatus{
+			Certificate: in.Status.Certificate,
+		},
+	}
+	// An empty signer is defaulted by v1beta1, and rejected by v1
+	if in.Spec.SignerName != "" {
+		out.Spec.SignerName = ptrToString(in.Spec.SignerName)
+	}
+	for _, u := range in.Spec.Usages {
+		out.Spec.Usages = append(out.Spec.Usages, certificatesv1beta1.KeyUsage(u))
+	}
+	if in.Spec.Extra != nil {
+		out.Spec.Extra = make(map[string]certificatesv1beta1.ExtraValue, len(in.Spec.Extra))
+		for k, v := range in.Spec.Extra {
+			out.Spec.Extra[k] = certificatesv1beta1.ExtraValue(v)
+		}
+	}
+	for _, c := range in.Status.Conditions {
+		out.Status.Conditions = append(out.Status.Conditions, certificatesv1beta1.CertificateSigningRequestCondition{
+			Type:               certificatesv1beta1.RequestConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastUpdateTime:     c.LastUpdateTime,
+			LastTransitionTime: c.LastTransitionTime,
+		})
 	}
 	return out
 }
+
+func certificateSigningRequestFromV1beta1(in *certificatesv1beta1.CertificateSigningRequest) *certificates.CertificateSigningRequest {
+	out := &certificates.CertificateSigningRequest{
+		ObjectMeta: in.ObjectMeta,
+		Spec: certificates.CertificateSigningRequestSpec{
+			Request:  in.Spec.Request,
+			Username: in.Spec.Username,
+			UID:      in.Spec.UID,
+			Groups:   in.Spec.Groups,
+		},
+		Status: certificates.CertificateSigningRequestStatus{
+			Certificate: in.Status.Certificate,
+		},
+	}
+	if in.Spec.SignerName != nil {
+		out.Spec.SignerName = *in.Spec.SignerName
+	}
+	for _, u := range in.Spec.Usages {
+		out.Spec.Usages = append(out.Spec.Usages, certificates.KeyUsage(u))
+	}
+	if in.Spec.Extra != nil {
+		out.Spec.Extra = make(map[string]certificates.ExtraValue, len(in.Spec.Extra))
+		for k, v := range in.Spec.Extra {
+			out.Spec.Extra[k] = certificates.ExtraValue(v)
+		}
+	}
+	for _, c := range in.Status.Conditions {
+		out.Status.Conditions = append(out.Status.Conditions, certificates.CertificateSigningRequestCondition{
+			Type:               certificates.RequestConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastUpdateTime:     c.LastUpdateTime,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return out
+}
+
+func parseCertificatePEM(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("Failed to decode PEM-encoded certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// certificateReadyForRenewal reports whether the certificate expires
+// within renewBefore of now, or has already expired.
+func certificateReadyForRenewal(cert *x509.Certificate, renewBefore time.Duration, now time.Time) bool {
+	return !now.Add(renewBefore).Before(cert.NotAfter)
+}
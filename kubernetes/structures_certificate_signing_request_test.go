@@ -0,0 +1,101 @@
+package kubernetes
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	certificates "k8s.io/api/certificates/v1"
+	api "k8s.io/api/core/v1"
+)
+
+func TestCertificateReadyForRenewal(t *testing.T) {
+	notAfter := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	certificate := testCertificatePEM(t, notAfter)
+
+	cert, err := parseCertificatePEM(certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cert.NotAfter.Equal(notAfter) {
+		t.Fatalf("Expected certificate to expire at %s, given: %s", notAfter, cert.NotAfter)
+	}
+	if cert.SerialNumber.String() != "1234" {
+		t.Fatalf("Expected serial 1234, given: %s", cert.SerialNumber)
+	}
+
+	testCases := []struct {
+		Now         time.Time
+		RenewBefore time.Duration
+		Expected    bool
+	}{
+		{notAfter.Add(-48 * time.Hour), 0, false},
+		{notAfter.Add(-48 * time.Hour), 24 * time.Hour, false},
+		{notAfter.Add(-12 * time.Hour), 24 * time.Hour, true},
+		{notAfter, 0, true},
+		{notAfter.Add(time.Hour), 0, true},
+	}
+	for _, tc := range testCases {
+		if v := certificateReadyForRenewal(cert, tc.RenewBefore, tc.Now); v != tc.Expected {
+			t.Fatalf("Expected renewal at %s with renew_before %s to be %t", tc.Now, tc.RenewBefore, tc.Expected)
+		}
+	}
+
+	if _, err := parseCertificatePEM("-----BEGIN CERTIFICATE REQUEST-----\n-----END CERTIFICATE REQUEST-----\n"); err == nil {
+		t.Fatal("Expected a certificate request to be rejected")
+	}
+}
+
+func testCertificatePEM(t *testing.T, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject:      pkix.Name{CommonName: "admin"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestCertificateSigningRequestV1beta1Conversion(t *testing.T) {
+	in := &certificates.CertificateSigningRequest{
+		Spec: certificates.CertificateSigningRequestSpec{
+			Request: []byte("request"),
+			Usages:  []certificates.KeyUsage{certificates.UsageClientAuth},
+		},
+		Status: certificates.CertificateSigningRequestStatus{
+			Conditions: []certificates.CertificateSigningRequestCondition{
+				{Type: certificates.CertificateApproved, Status: api.ConditionTrue, Reason: "TerraformAutoApprove"},
+			},
+		},
+	}
+
+	out := certificateSigningRequestToV1beta1(in)
+	if out.Spec.SignerName != nil {
+		t.Fatalf("Expected an empty signer name to be left for the server to default, given: %q", *out.Spec.SignerName)
+	}
+	back := certificateSigningRequestFromV1beta1(out)
+	if !reflect.DeepEqual(in, back) {
+		t.Fatalf("Request changed by the conversion to v1beta1 and back:\n\tWant: %#v\n\tGot: %#v", in, back)
+	}
+
+	in.Spec.SignerName = certificates.KubeAPIServerClientSignerName
+	out = certificateSigningRequestToV1beta1(in)
+	if out.Spec.SignerName == nil || *out.Spec.SignerName != certificates.KubeAPIServerClientSignerName {
+		t.Fatalf("Unexpected signer name: %v", out.Spec.SignerName)
+	}
+}
@@ -44,6 +44,12 @@ func flattenContainerSecurityContext(in *v1.SecurityContext) []interface{} {
 	if in.SELinuxOptions != nil {
 		att["se_linux_options"] = flattenSeLinuxOptions(in.SELinuxOptions)
 	}
+	if in.SeccompProfile != nil {
+		att["seccomp_profile"] = flattenSeccompProfile(in.SeccompProfile)
+	}
+	if in.WindowsOptions != nil {
+		att["windows_options"] = flattenWindowsOptions(in.WindowsOptions)
+	}
 	return []interface{}{att}
 
 }
@@ -436,6 +442,40 @@ func removeVolumeMountFromContainer(i int, v []v1.VolumeMount) []v1.VolumeMount
 	return append(v[:i], v[i+1:]...)
 }
 
+func flattenEphemeralContainers(in []v1.EphemeralContainer, serviceAccountRegex string) ([]interface{}, error) {
+	containers := make([]v1.Container, len(in))
+	for i, v := range in {
+		containers[i] = v1.Container(v.EphemeralContainerCommon)
+	}
+	att, err := flattenContainers(containers, serviceAccountRegex)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range in {
+		c := att[i].(map[string]interface{})
+		if v.TargetContainerName != "" {
+			c["target_container_name"] = v.TargetContainerName
+		}
+	}
+	return att, nil
+}
+
+func expandEphemeralContainers(ctrs []interface{}) ([]v1.EphemeralContainer, error) {
+	containers, err := expandContainers(ctrs)
+	if err != nil {
+		return nil, err
+	}
+	ecs := make([]v1.EphemeralContainer, len(containers))
+	for i, c := range containers {
+		ecs[i].EphemeralContainerCommon = v1.EphemeralContainerCommon(c)
+		ctr := ctrs[i].(map[string]interface{})
+		if v, ok := ctr["target_container_name"].(string); ok {
+			ecs[i].TargetContainerName = v
+		}
+	}
+	return ecs, nil
+}
+
 func expandContainers(ctrs []interface{}) ([]v1.Container, error) {
 	if len(ctrs) == 0 {
 		return []v1.Container{}, nil
@@ -619,6 +659,12 @@ func expandContainerSecurityContext(l []interface{}) (*v1.SecurityContext, error
 	if v, ok := in["se_linux_options"].([]interface{}); ok && len(v) > 0 {
 		obj.SELinuxOptions = expandSeLinuxOptions(v)
 	}
+	if v, ok := in["seccomp_profile"].([]interface{}); ok && len(v) > 0 {
+		obj.SeccompProfile = expandSeccompProfile(v)
+	}
+	if v, ok := in["windows_options"].([]interface{}); ok && len(v) > 0 {
+		obj.WindowsOptions = expandWindowsOptions(v)
+	}
 
 	return &obj, nil
 }
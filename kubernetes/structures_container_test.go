@@ -212,3 +212,45 @@ func TestExpandConfigMapKeyRef(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandThenFlatten_ephemeral_containers(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"name":                  "debugger",
+			"image":                 "busybox",
+			"command":               []interface{}{"sleep", "3600"},
+			"target_container_name": "app",
+		},
+		map[string]interface{}{
+			"name":  "shell",
+			"image": "busybox",
+		},
+	}
+	ecs, err := expandEphemeralContainers(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ecs) != 2 {
+		t.Fatalf("Expected 2 ephemeral containers, got %d", len(ecs))
+	}
+	if ecs[0].Name != "debugger" || ecs[0].TargetContainerName != "app" || !reflect.DeepEqual(ecs[0].Command, []string{"sleep", "3600"}) {
+		t.Fatalf("Unexpected ephemeral container: %#v", ecs[0])
+	}
+	if ecs[1].TargetContainerName != "" {
+		t.Fatalf("Unexpected target container name: %q", ecs[1].TargetContainerName)
+	}
+
+	out, err := flattenEphemeralContainers(ecs, "default-token-([a-z0-9]{5})")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, c := range out {
+		att := c.(map[string]interface{})
+		expected := in[i].(map[string]interface{})
+		for _, k := range []string{"name", "image", "target_container_name"} {
+			if !reflect.DeepEqual(att[k], expected[k]) {
+				t.Fatalf("Unexpected %s after flattening.\nExpected: %#v\nGiven:    %#v", k, expected[k], att[k])
+			}
+		}
+	}
+}
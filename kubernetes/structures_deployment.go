@@ -9,8 +9,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData, meta interface{}, prefix ...string) ([]interface{}, error) {
 	att := make(map[string]interface{})
+	p := ""
+	if len(prefix) > 0 {
+		p = prefix[0]
+	}
 	att["min_ready_seconds"] = in.MinReadySeconds
 
 	if in.Replicas != nil {
@@ -37,12 +41,22 @@ func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData) ([]
 	}
 	template := make(map[string]interface{})
 	template["spec"] = podSpec
-	template["metadata"] = flattenMetadata(in.Template.ObjectMeta, d, "spec.0.template.0.")
+	template["metadata"] = flattenTemplateMetadata(in.Template.ObjectMeta, d, meta, p+"spec.0.template.0.")
 	att["template"] = []interface{}{template}
 
 	return []interface{}{att}, nil
 }
 
+func flattenDeploymentStatus(in appsv1.DeploymentStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["replicas"] = int(in.Replicas)
+	att["updated_replicas"] = int(in.UpdatedReplicas)
+	att["ready_replicas"] = int(in.ReadyReplicas)
+	att["available_replicas"] = int(in.AvailableReplicas)
+	att["unavailable_replicas"] = int(in.UnavailableReplicas)
+	return []interface{}{att}
+}
+
 func flattenDeploymentStrategy(in appsv1.DeploymentStrategy) []interface{} {
 	att := make(map[string]interface{})
 	if in.Type != "" {
@@ -0,0 +1,72 @@
+package kubernetes
+
+import (
+	api "k8s.io/api/core/v1"
+)
+
+func expandNodeTaints(l []interface{}) []api.Taint {
+	taints := make([]api.Taint, 0, len(l))
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		taints = append(taints, api.Taint{
+			Key:    m["key"].(string),
+			Value:  m["value"].(string),
+			Effect: api.TaintEffect(m["effect"].(string)),
+		})
+	}
+	return taints
+}
+
+func flattenNodeTaints(taints []api.Taint) []interface{} {
+	att := make([]interface{}, len(taints))
+	for i, t := range taints {
+		att[i] = map[string]interface{}{
+			"key":    t.Key,
+			"value":  t.Value,
+			"effect": string(t.Effect),
+		}
+	}
+	return att
+}
+
+func flattenNodeSpec(in api.NodeSpec) []interface{} {
+	att := make(map[string]interface{})
+	att["pod_cidr"] = in.PodCIDR
+	att["pod_cidrs"] = in.PodCIDRs
+	att["provider_id"] = in.ProviderID
+	att["unschedulable"] = in.Unschedulable
+	att["taint"] = flattenNodeTaints(in.Taints)
+	return []interface{}{att}
+}
+
+func flattenNodeStatus(in api.NodeStatus) []interface{} {
+	att := make(map[string]interface{})
+	addresses := make([]interface{}, len(in.Addresses))
+	for i, a := range in.Addresses {
+		addresses[i] = map[string]interface{}{
+			"address": a.Address,
+			"type":    string(a.Type),
+		}
+	}
+	att["addresses"] = addresses
+	att["allocatable"] = flattenResourceList(in.Allocatable)
+	att["capacity"] = flattenResourceList(in.Capacity)
+	att["node_info"] = []interface{}{
+		map[string]interface{}{
+			"architecture":              in.NodeInfo.Architecture,
+			"boot_id":                   in.NodeInfo.BootID,
+			"container_runtime_version": in.NodeInfo.ContainerRuntimeVersion,
+			"kernel_version":            in.NodeInfo.KernelVersion,
+			"kube_proxy_version":        in.NodeInfo.KubeProxyVersion,
+			"kubelet_version":           in.NodeInfo.KubeletVersion,
+			"machine_id":                in.NodeInfo.MachineID,
+			"operating_system":          in.NodeInfo.OperatingSystem,
+			"os_image":                  in.NodeInfo.OSImage,
+			"system_uuid":               in.NodeInfo.SystemUUID,
+		},
+	}
+	return []interface{}{att}
+}
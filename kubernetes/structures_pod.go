@@ -29,11 +29,7 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	}
 
 	// To avoid perpetual diff, remove the service account token volume from PodSpec.
-	serviceAccountName := "default"
-	if in.ServiceAccountName != "" {
-		serviceAccountName = in.ServiceAccountName
-	}
-	serviceAccountRegex := fmt.Sprintf("%s-token-([a-z0-9]{5})", serviceAccountName)
+	serviceAccountRegex := serviceAccountTokenRegex(in)
 
 	containers, err := flattenContainers(in.Containers, serviceAccountRegex)
 	if err != nil {
@@ -83,12 +79,24 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if len(in.NodeSelector) > 0 {
 		att["node_selector"] = in.NodeSelector
 	}
+	if len(in.Overhead) > 0 {
+		att["overhead"] = flattenResourceList(in.Overhead)
+	}
+	if in.PreemptionPolicy != nil {
+		att["preemption_policy"] = string(*in.PreemptionPolicy)
+	}
 	if in.PriorityClassName != "" {
 		att["priority_class_name"] = in.PriorityClassName
 	}
 	if in.RestartPolicy != "" {
 		att["restart_policy"] = in.RestartPolicy
 	}
+	if in.RuntimeClassName != nil {
+		att["runtime_class_name"] = *in.RuntimeClassName
+	}
+	if in.SchedulerName != "" {
+		att["scheduler_name"] = in.SchedulerName
+	}
 
 	if in.SecurityContext != nil {
 		att["security_context"] = flattenPodSecurityContext(in.SecurityContext)
@@ -97,6 +105,9 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if in.ServiceAccountName != "" {
 		att["service_account_name"] = in.ServiceAccountName
 	}
+	if in.SetHostnameAsFQDN != nil {
+		att["set_hostname_as_fqdn"] = *in.SetHostnameAsFQDN
+	}
 	if in.ShareProcessNamespace != nil {
 		att["share_process_namespace"] = *in.ShareProcessNamespace
 	}
@@ -113,6 +124,10 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 		att["toleration"] = flattenTolerations(in.Tolerations)
 	}
 
+	if len(in.TopologySpreadConstraints) > 0 {
+		att["topology_spread_constraint"] = flattenTopologySpreadConstraints(in.TopologySpreadConstraints)
+	}
+
 	if len(in.Volumes) > 0 {
 		for i, volume := range in.Volumes {
 			// To avoid perpetual diff, remove the service account token volume from PodSpec.
@@ -185,6 +200,9 @@ func flattenPodSecurityContext(in *v1.PodSecurityContext) []interface{} {
 	if in.FSGroup != nil {
 		att["fs_group"] = strconv.Itoa(int(*in.FSGroup))
 	}
+	if in.FSGroupChangePolicy != nil {
+		att["fs_group_change_policy"] = string(*in.FSGroupChangePolicy)
+	}
 	if in.RunAsGroup != nil {
 		att["run_as_group"] = strconv.Itoa(int(*in.RunAsGroup))
 	}
@@ -202,9 +220,15 @@ func flattenPodSecurityContext(in *v1.PodSecurityContext) []interface{} {
 	if in.SELinuxOptions != nil {
 		att["se_linux_options"] = flattenSeLinuxOptions(in.SELinuxOptions)
 	}
+	if in.SeccompProfile != nil {
+		att["seccomp_profile"] = flattenSeccompProfile(in.SeccompProfile)
+	}
 	if in.Sysctls != nil {
 		att["sysctl"] = flattenSysctls(in.Sysctls)
 	}
+	if in.WindowsOptions != nil {
+		att["windows_options"] = flattenWindowsOptions(in.WindowsOptions)
+	}
 
 	if len(att) > 0 {
 		return []interface{}{att}
@@ -212,6 +236,39 @@ func flattenPodSecurityContext(in *v1.PodSecurityContext) []interface{} {
 	return []interface{}{}
 }
 
+// serviceAccountTokenRegex matches the name of the token volume the service account
+// admission controller mounts into the containers of the given pod spec.
+func serviceAccountTokenRegex(in v1.PodSpec) string {
+	serviceAccountName := "default"
+	if in.ServiceAccountName != "" {
+		serviceAccountName = in.ServiceAccountName
+	}
+	return fmt.Sprintf("%s-token-([a-z0-9]{5})", serviceAccountName)
+}
+
+func flattenSeccompProfile(in *v1.SeccompProfile) []interface{} {
+	att := make(map[string]interface{})
+	att["type"] = string(in.Type)
+	if in.LocalhostProfile != nil {
+		att["localhost_profile"] = *in.LocalhostProfile
+	}
+	return []interface{}{att}
+}
+
+func flattenWindowsOptions(in *v1.WindowsSecurityContextOptions) []interface{} {
+	att := make(map[string]interface{})
+	if in.GMSACredentialSpec != nil {
+		att["gmsa_credential_spec"] = *in.GMSACredentialSpec
+	}
+	if in.GMSACredentialSpecName != nil {
+		att["gmsa_credential_spec_name"] = *in.GMSACredentialSpecName
+	}
+	if in.RunAsUserName != nil {
+		att["run_as_username"] = *in.RunAsUserName
+	}
+	return []interface{}{att}
+}
+
 func flattenSeLinuxOptions(in *v1.SELinuxOptions) []interface{} {
 	att := make(map[string]interface{})
 	if in.User != "" {
@@ -298,6 +355,12 @@ func flattenVolumes(volumes []v1.Volume) ([]interface{}, error) {
 		if v.PersistentVolumeClaim != nil {
 			obj["persistent_volume_claim"] = flattenPersistentVolumeClaimVolumeSource(v.PersistentVolumeClaim)
 		}
+		if v.Ephemeral != nil {
+			obj["ephemeral"] = flattenEphemeralVolumeSource(v.Ephemeral)
+		}
+		if v.CSI != nil {
+			obj["csi"] = flattenInlineCSIVolumeSource(v.CSI)
+		}
 		if v.Secret != nil {
 			obj["secret"] = flattenSecretVolumeSource(v.Secret)
 		}
@@ -371,6 +434,46 @@ func flattenPersistentVolumeClaimVolumeSource(in *v1.PersistentVolumeClaimVolume
 
 	return []interface{}{att}
 }
+
+func flattenEphemeralVolumeSource(in *v1.EphemeralVolumeSource) []interface{} {
+	att := make(map[string]interface{})
+	if in.VolumeClaimTemplate != nil {
+		template := map[string]interface{}{
+			"spec": flattenPersistentVolumeClaimSpec(in.VolumeClaimTemplate.Spec),
+		}
+		meta := make(map[string]interface{})
+		if len(in.VolumeClaimTemplate.Labels) > 0 {
+			meta["labels"] = in.VolumeClaimTemplate.Labels
+		}
+		if len(in.VolumeClaimTemplate.Annotations) > 0 {
+			meta["annotations"] = in.VolumeClaimTemplate.Annotations
+		}
+		if len(meta) > 0 {
+			template["metadata"] = []interface{}{meta}
+		}
+		att["volume_claim_template"] = []interface{}{template}
+	}
+	return []interface{}{att}
+}
+
+func flattenInlineCSIVolumeSource(in *v1.CSIVolumeSource) []interface{} {
+	att := make(map[string]interface{})
+	att["driver"] = in.Driver
+	if in.FSType != nil {
+		att["fs_type"] = *in.FSType
+	}
+	if in.ReadOnly != nil {
+		att["read_only"] = *in.ReadOnly
+	}
+	if len(in.VolumeAttributes) > 0 {
+		att["volume_attributes"] = in.VolumeAttributes
+	}
+	if in.NodePublishSecretRef != nil {
+		att["node_publish_secret_ref"] = flattenLocalObjectReference(in.NodePublishSecretRef)
+	}
+	return []interface{}{att}
+}
+
 func flattenGitRepoVolumeSource(in *v1.GitRepoVolumeSource) []interface{} {
 	att := make(map[string]interface{})
 	if in.Directory != "" {
@@ -697,6 +800,19 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.NodeSelector = nodeSelectors
 	}
 
+	if v, ok := in["overhead"].(map[string]interface{}); ok && len(v) > 0 {
+		rl, err := expandMapToResourceList(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.Overhead = *rl
+	}
+
+	if v, ok := in["preemption_policy"].(string); ok && v != "" {
+		policy := v1.PreemptionPolicy(v)
+		obj.PreemptionPolicy = &policy
+	}
+
 	if v, ok := in["priority_class_name"].(string); ok {
 		obj.PriorityClassName = v
 	}
@@ -705,6 +821,14 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.RestartPolicy = v1.RestartPolicy(v)
 	}
 
+	if v, ok := in["runtime_class_name"].(string); ok && v != "" {
+		obj.RuntimeClassName = ptrToString(v)
+	}
+
+	if v, ok := in["scheduler_name"].(string); ok {
+		obj.SchedulerName = v
+	}
+
 	if v, ok := in["security_context"].([]interface{}); ok && len(v) > 0 {
 		ctx, err := expandPodSecurityContext(v)
 		if err != nil {
@@ -717,6 +841,10 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.ServiceAccountName = v
 	}
 
+	if v, ok := in["set_hostname_as_fqdn"].(bool); ok && v {
+		obj.SetHostnameAsFQDN = ptrToBool(v)
+	}
+
 	if v, ok := in["share_process_namespace"]; ok {
 		obj.ShareProcessNamespace = ptrToBool(v.(bool))
 	}
@@ -739,6 +867,10 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		}
 	}
 
+	if v, ok := in["topology_spread_constraint"].([]interface{}); ok && len(v) > 0 {
+		obj.TopologySpreadConstraints = expandTopologySpreadConstraints(v)
+	}
+
 	if v, ok := in["volume"].([]interface{}); ok && len(v) > 0 {
 		cs, err := expandVolumes(v)
 		if err != nil {
@@ -804,6 +936,10 @@ func expandPodSecurityContext(l []interface{}) (*v1.PodSecurityContext, error) {
 		}
 		obj.FSGroup = ptrToInt64(int64(i))
 	}
+	if v, ok := in["fs_group_change_policy"].(string); ok && v != "" {
+		policy := v1.PodFSGroupChangePolicy(v)
+		obj.FSGroupChangePolicy = &policy
+	}
 	if v, ok := in["run_as_group"].(string); ok && v != "" {
 		i, err := strconv.ParseInt(v, 10, 64)
 		if err != nil {
@@ -827,9 +963,15 @@ func expandPodSecurityContext(l []interface{}) (*v1.PodSecurityContext, error) {
 	if v, ok := in["supplemental_groups"].(*schema.Set); ok {
 		obj.SupplementalGroups = schemaSetToInt64Array(v)
 	}
+	if v, ok := in["seccomp_profile"].([]interface{}); ok && len(v) > 0 {
+		obj.SeccompProfile = expandSeccompProfile(v)
+	}
 	if v, ok := in["sysctl"].([]interface{}); ok && len(v) > 0 {
 		obj.Sysctls = expandSysctls(v)
 	}
+	if v, ok := in["windows_options"].([]interface{}); ok && len(v) > 0 {
+		obj.WindowsOptions = expandWindowsOptions(v)
+	}
 
 	return obj, nil
 }
@@ -873,6 +1015,39 @@ func expandSeLinuxOptions(l []interface{}) *v1.SELinuxOptions {
 	return obj
 }
 
+func expandSeccompProfile(l []interface{}) *v1.SeccompProfile {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.SeccompProfile{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.SeccompProfile{}
+	if v, ok := in["type"].(string); ok {
+		obj.Type = v1.SeccompProfileType(v)
+	}
+	if v, ok := in["localhost_profile"].(string); ok && v != "" {
+		obj.LocalhostProfile = ptrToString(v)
+	}
+	return obj
+}
+
+func expandWindowsOptions(l []interface{}) *v1.WindowsSecurityContextOptions {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.WindowsSecurityContextOptions{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.WindowsSecurityContextOptions{}
+	if v, ok := in["gmsa_credential_spec"].(string); ok && v != "" {
+		obj.GMSACredentialSpec = ptrToString(v)
+	}
+	if v, ok := in["gmsa_credential_spec_name"].(string); ok && v != "" {
+		obj.GMSACredentialSpecName = ptrToString(v)
+	}
+	if v, ok := in["run_as_username"].(string); ok && v != "" {
+		obj.RunAsUserName = ptrToString(v)
+	}
+	return obj
+}
+
 func expandKeyPath(in []interface{}) []v1.KeyToPath {
 	if len(in) == 0 {
 		return []v1.KeyToPath{}
@@ -1037,6 +1212,58 @@ func expandPersistentVolumeClaimVolumeSource(l []interface{}) *v1.PersistentVolu
 	return obj
 }
 
+func expandEphemeralVolumeSource(l []interface{}) (*v1.EphemeralVolumeSource, error) {
+	obj := &v1.EphemeralVolumeSource{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["volume_claim_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		t := v[0].(map[string]interface{})
+		template := &v1.PersistentVolumeClaimTemplate{}
+		if m, ok := t["metadata"].([]interface{}); ok && len(m) > 0 && m[0] != nil {
+			meta := m[0].(map[string]interface{})
+			if labels, ok := meta["labels"].(map[string]interface{}); ok && len(labels) > 0 {
+				template.Labels = expandStringMap(labels)
+			}
+			if annotations, ok := meta["annotations"].(map[string]interface{}); ok && len(annotations) > 0 {
+				template.Annotations = expandStringMap(annotations)
+			}
+		}
+		spec, err := expandPersistentVolumeClaimSpec(t["spec"].([]interface{}))
+		if err != nil {
+			return obj, err
+		}
+		template.Spec = *spec
+		obj.VolumeClaimTemplate = template
+	}
+	return obj, nil
+}
+
+func expandInlineCSIVolumeSource(l []interface{}) *v1.CSIVolumeSource {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.CSIVolumeSource{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.CSIVolumeSource{
+		Driver: in["driver"].(string),
+	}
+	if v, ok := in["fs_type"].(string); ok && v != "" {
+		obj.FSType = ptrToString(v)
+	}
+	if v, ok := in["read_only"].(bool); ok && v {
+		obj.ReadOnly = ptrToBool(v)
+	}
+	if v, ok := in["volume_attributes"].(map[string]interface{}); ok && len(v) > 0 {
+		obj.VolumeAttributes = expandStringMap(v)
+	}
+	if v, ok := in["node_publish_secret_ref"].([]interface{}); ok && len(v) > 0 {
+		obj.NodePublishSecretRef = expandLocalObjectReference(v)
+	}
+	return obj
+}
+
 func expandSecretVolumeSource(l []interface{}) (*v1.SecretVolumeSource, error) {
 	obj := &v1.SecretVolumeSource{}
 	if len(l) == 0 || l[0] == nil {
@@ -1308,6 +1535,16 @@ func expandVolumes(volumes []interface{}) ([]v1.Volume, error) {
 		if value, ok := m["persistent_volume_claim"].([]interface{}); ok && len(value) > 0 {
 			vl[i].PersistentVolumeClaim = expandPersistentVolumeClaimVolumeSource(value)
 		}
+		if value, ok := m["ephemeral"].([]interface{}); ok && len(value) > 0 {
+			ev, err := expandEphemeralVolumeSource(value)
+			if err != nil {
+				return vl, err
+			}
+			vl[i].Ephemeral = ev
+		}
+		if value, ok := m["csi"].([]interface{}); ok && len(value) > 0 {
+			vl[i].CSI = expandInlineCSIVolumeSource(value)
+		}
 		if value, ok := m["secret"].([]interface{}); ok && len(value) > 0 {
 			sc, err := expandSecretVolumeSource(value)
 			if err != nil {
@@ -1419,3 +1656,44 @@ func patchPodSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOpera
 	}
 	return ops, nil
 }
+
+func flattenTopologySpreadConstraints(in []v1.TopologySpreadConstraint) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		m := map[string]interface{}{
+			"max_skew":           int(v.MaxSkew),
+			"topology_key":       v.TopologyKey,
+			"when_unsatisfiable": string(v.WhenUnsatisfiable),
+		}
+		if v.LabelSelector != nil {
+			m["label_selector"] = flattenLabelSelector(v.LabelSelector)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func expandTopologySpreadConstraints(l []interface{}) []v1.TopologySpreadConstraint {
+	obj := make([]v1.TopologySpreadConstraint, 0, len(l))
+	for _, c := range l {
+		if c == nil {
+			continue
+		}
+		in := c.(map[string]interface{})
+		constraint := v1.TopologySpreadConstraint{}
+		if v, ok := in["max_skew"].(int); ok {
+			constraint.MaxSkew = int32(v)
+		}
+		if v, ok := in["topology_key"].(string); ok {
+			constraint.TopologyKey = v
+		}
+		if v, ok := in["when_unsatisfiable"].(string); ok {
+			constraint.WhenUnsatisfiable = v1.UnsatisfiableConstraintAction(v)
+		}
+		if v, ok := in["label_selector"].([]interface{}); ok && len(v) > 0 {
+			constraint.LabelSelector = expandLabelSelector(v)
+		}
+		obj = append(obj, constraint)
+	}
+	return obj
+}
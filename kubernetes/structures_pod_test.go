@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestFlattenTolerations(t *testing.T) {
@@ -152,6 +154,337 @@ func TestExpandTolerations(t *testing.T) {
 	}
 }
 
+func TestFlattenTopologySpreadConstraints(t *testing.T) {
+	cases := []struct {
+		Input          []v1.TopologySpreadConstraint
+		ExpectedOutput []interface{}
+	}{
+		{
+			[]v1.TopologySpreadConstraint{
+				{
+					MaxSkew:           1,
+					TopologyKey:       "topology.kubernetes.io/zone",
+					WhenUnsatisfiable: v1.DoNotSchedule,
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "web"},
+					},
+				},
+				{
+					MaxSkew:           2,
+					TopologyKey:       "kubernetes.io/hostname",
+					WhenUnsatisfiable: v1.ScheduleAnyway,
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"max_skew":           1,
+					"topology_key":       "topology.kubernetes.io/zone",
+					"when_unsatisfiable": "DoNotSchedule",
+					"label_selector": []interface{}{
+						map[string]interface{}{
+							"match_labels": map[string]string{"app": "web"},
+						},
+					},
+				},
+				map[string]interface{}{
+					"max_skew":           2,
+					"topology_key":       "kubernetes.io/hostname",
+					"when_unsatisfiable": "ScheduleAnyway",
+				},
+			},
+		},
+		{
+			[]v1.TopologySpreadConstraint{},
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenTopologySpreadConstraints(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandTopologySpreadConstraints(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput []v1.TopologySpreadConstraint
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"max_skew":           1,
+					"topology_key":       "topology.kubernetes.io/zone",
+					"when_unsatisfiable": "DoNotSchedule",
+					"label_selector": []interface{}{
+						map[string]interface{}{
+							"match_labels": map[string]interface{}{"app": "web"},
+						},
+					},
+				},
+				map[string]interface{}{
+					"max_skew":           2,
+					"topology_key":       "kubernetes.io/hostname",
+					"when_unsatisfiable": "ScheduleAnyway",
+					"label_selector":     []interface{}{},
+				},
+			},
+			[]v1.TopologySpreadConstraint{
+				{
+					MaxSkew:           1,
+					TopologyKey:       "topology.kubernetes.io/zone",
+					WhenUnsatisfiable: v1.DoNotSchedule,
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "web"},
+					},
+				},
+				{
+					MaxSkew:           2,
+					TopologyKey:       "kubernetes.io/hostname",
+					WhenUnsatisfiable: v1.ScheduleAnyway,
+				},
+			},
+		},
+		{
+			[]interface{}{},
+			[]v1.TopologySpreadConstraint{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandTopologySpreadConstraints(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandPodSpec_scheduling(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"overhead":             map[string]interface{}{"cpu": "250m"},
+			"preemption_policy":    "Never",
+			"runtime_class_name":   "gvisor",
+			"scheduler_name":       "custom-scheduler",
+			"set_hostname_as_fqdn": true,
+		},
+	}
+	spec, err := expandPodSpec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.Overhead.Cpu().String() != "250m" {
+		t.Fatalf("Unexpected overhead: %#v", spec.Overhead)
+	}
+	if spec.PreemptionPolicy == nil || *spec.PreemptionPolicy != v1.PreemptNever {
+		t.Fatalf("Unexpected preemption policy: %#v", spec.PreemptionPolicy)
+	}
+	if spec.SchedulerName != "custom-scheduler" {
+		t.Fatalf("Unexpected scheduler name: %q", spec.SchedulerName)
+	}
+	if spec.SetHostnameAsFQDN == nil || !*spec.SetHostnameAsFQDN {
+		t.Fatalf("Unexpected set_hostname_as_fqdn: %#v", spec.SetHostnameAsFQDN)
+	}
+
+	out, err := flattenPodSpec(*spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	att := out[0].(map[string]interface{})
+	expected := map[string]interface{}{
+		"overhead":             map[string]string{"cpu": "250m"},
+		"preemption_policy":    "Never",
+		"runtime_class_name":   "gvisor",
+		"scheduler_name":       "custom-scheduler",
+		"set_hostname_as_fqdn": true,
+	}
+	for k, v := range expected {
+		if !reflect.DeepEqual(att[k], v) {
+			t.Fatalf("Unexpected %s after flattening.\nExpected: %#v\nGiven:    %#v", k, v, att[k])
+		}
+	}
+}
+
+func TestExpandThenFlatten_pod_security_context(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"fs_group":               "100",
+			"fs_group_change_policy": "OnRootMismatch",
+			"seccomp_profile": []interface{}{
+				map[string]interface{}{
+					"localhost_profile": "profiles/audit.json",
+					"type":              "Localhost",
+				},
+			},
+			"windows_options": []interface{}{
+				map[string]interface{}{
+					"gmsa_credential_spec_name": "webapp",
+					"run_as_username":           "ContainerUser",
+				},
+			},
+		},
+	}
+	sc, err := expandPodSecurityContext(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sc.FSGroupChangePolicy == nil || *sc.FSGroupChangePolicy != v1.FSGroupChangeOnRootMismatch {
+		t.Fatalf("Unexpected fs_group_change_policy: %#v", sc.FSGroupChangePolicy)
+	}
+	expectedSeccomp := &v1.SeccompProfile{
+		Type:             v1.SeccompProfileTypeLocalhost,
+		LocalhostProfile: ptrToString("profiles/audit.json"),
+	}
+	if diff := cmp.Diff(expectedSeccomp, sc.SeccompProfile); diff != "" {
+		t.Fatalf("Unexpected seccomp_profile (-want +got):\n%s", diff)
+	}
+	expectedWindows := &v1.WindowsSecurityContextOptions{
+		GMSACredentialSpecName: ptrToString("webapp"),
+		RunAsUserName:          ptrToString("ContainerUser"),
+	}
+	if diff := cmp.Diff(expectedWindows, sc.WindowsOptions); diff != "" {
+		t.Fatalf("Unexpected windows_options (-want +got):\n%s", diff)
+	}
+
+	out := flattenPodSecurityContext(sc)
+	if diff := cmp.Diff(in, out); diff != "" {
+		t.Fatalf("Unexpected result of flattening (-want +got):\n%s", diff)
+	}
+}
+
+func TestExpandEphemeralVolumeSource(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"volume_claim_template": []interface{}{
+				map[string]interface{}{
+					"metadata": []interface{}{
+						map[string]interface{}{
+							"labels": map[string]interface{}{"type": "scratch"},
+						},
+					},
+					"spec": []interface{}{
+						map[string]interface{}{
+							"access_modes": schema.NewSet(schema.HashString, []interface{}{"ReadWriteOnce"}),
+							"resources": []interface{}{
+								map[string]interface{}{
+									"requests": map[string]interface{}{"storage": "1Gi"},
+								},
+							},
+							"storage_class_name": "csi-scratch",
+						},
+					},
+				},
+			},
+		},
+	}
+	expected := &v1.EphemeralVolumeSource{
+		VolumeClaimTemplate: &v1.PersistentVolumeClaimTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{"type": "scratch"},
+			},
+			Spec: v1.PersistentVolumeClaimSpec{
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+				StorageClassName: ptrToString("csi-scratch"),
+			},
+		},
+	}
+
+	out, err := expandEphemeralVolumeSource(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(expected, out) {
+		t.Fatal(cmp.Diff(expected, out))
+	}
+
+	flattened := flattenEphemeralVolumeSource(out)
+	template := flattened[0].(map[string]interface{})["volume_claim_template"].([]interface{})[0].(map[string]interface{})
+	meta := template["metadata"].([]interface{})[0].(map[string]interface{})
+	if !reflect.DeepEqual(meta["labels"], map[string]string{"type": "scratch"}) {
+		t.Fatalf("Unexpected labels after flattening: %#v", meta["labels"])
+	}
+	spec := template["spec"].([]interface{})[0].(map[string]interface{})
+	if spec["storage_class_name"] != "csi-scratch" {
+		t.Fatalf("Unexpected storage_class_name after flattening: %#v", spec["storage_class_name"])
+	}
+}
+
+func TestExpandInlineCSIVolumeSource(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *v1.CSIVolumeSource
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"driver": "secrets-store.csi.k8s.io",
+				},
+			},
+			&v1.CSIVolumeSource{
+				Driver: "secrets-store.csi.k8s.io",
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"driver":            "inline.storage.kubernetes.io",
+					"fs_type":           "ext4",
+					"read_only":         true,
+					"volume_attributes": map[string]interface{}{"foo": "bar"},
+					"node_publish_secret_ref": []interface{}{
+						map[string]interface{}{"name": "csi-secret"},
+					},
+				},
+			},
+			&v1.CSIVolumeSource{
+				Driver:               "inline.storage.kubernetes.io",
+				FSType:               ptrToString("ext4"),
+				ReadOnly:             ptrToBool(true),
+				VolumeAttributes:     map[string]string{"foo": "bar"},
+				NodePublishSecretRef: &v1.LocalObjectReference{Name: "csi-secret"},
+			},
+		},
+	}
+	for _, tc := range cases {
+		output := expandInlineCSIVolumeSource(tc.Input)
+		if !cmp.Equal(output, tc.ExpectedOutput) {
+			t.Fatal(cmp.Diff(tc.ExpectedOutput, output))
+		}
+	}
+}
+
+func TestFlattenInlineCSIVolumeSource(t *testing.T) {
+	in := &v1.CSIVolumeSource{
+		Driver:               "inline.storage.kubernetes.io",
+		FSType:               ptrToString("ext4"),
+		ReadOnly:             ptrToBool(true),
+		VolumeAttributes:     map[string]string{"foo": "bar"},
+		NodePublishSecretRef: &v1.LocalObjectReference{Name: "csi-secret"},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"driver":            "inline.storage.kubernetes.io",
+			"fs_type":           "ext4",
+			"read_only":         true,
+			"volume_attributes": map[string]string{"foo": "bar"},
+			"node_publish_secret_ref": []interface{}{
+				map[string]interface{}{"name": "csi-secret"},
+			},
+		},
+	}
+	output := flattenInlineCSIVolumeSource(in)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v", expected, output)
+	}
+}
+
 func TestFlattenSecretVolumeSource(t *testing.T) {
 	cases := []struct {
 		Input          *v1.SecretVolumeSource
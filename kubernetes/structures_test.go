@@ -1,8 +1,14 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestIsInternalKey(t *testing.T) {
@@ -30,3 +36,248 @@ func TestIsInternalKey(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveIgnoredKeys(t *testing.T) {
+	patterns, err := expandRegexpList([]interface{}{`^sidecar\.istio\.io/`, `^fluxcd\.io/sync-checksum$`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Live     map[string]string
+		Config   map[string]interface{}
+		Expected map[string]string
+	}{
+		{
+			map[string]string{"team": "infra", "sidecar.istio.io/status": "injected"},
+			map[string]interface{}{"team": "infra"},
+			map[string]string{"team": "infra"},
+		},
+		{
+			map[string]string{"sidecar.istio.io/inject": "false", "fluxcd.io/sync-checksum": "abc"},
+			map[string]interface{}{"sidecar.istio.io/inject": "false"},
+			map[string]string{"sidecar.istio.io/inject": "false"},
+		},
+		{
+			map[string]string{"fluxcd.io/sync-checksum-other": "abc"},
+			nil,
+			map[string]string{"fluxcd.io/sync-checksum-other": "abc"},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			out := removeIgnoredKeys(tc.Live, tc.Config, patterns)
+			if !reflect.DeepEqual(out, tc.Expected) {
+				t.Fatalf("Unexpected output.\nExpected: %#v\nGiven:    %#v", tc.Expected, out)
+			}
+		})
+	}
+}
+
+func TestPatchMetadata_ignoredKeys(t *testing.T) {
+	patterns, err := expandRegexpList([]interface{}{`^sidecar\.istio\.io/`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := &kubeClientsets{ignoreAnnotations: patterns}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("config map", true),
+		},
+	}
+	// The ignored annotation is hidden from the state, which has no annotations
+	state := &terraform.InstanceState{
+		ID: "default/test",
+		Attributes: map[string]string{
+			"id":                       "default/test",
+			"metadata.#":               "1",
+			"metadata.0.name":          "test",
+			"metadata.0.namespace":     "default",
+			"metadata.0.annotations.%": "0",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{
+				"name":        "test",
+				"annotations": map[string]interface{}{"team": "infra"},
+			},
+		},
+	})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Live        map[string]string
+		ExpectedOps PatchOperations
+	}{
+		{
+			map[string]string{"sidecar.istio.io/status": "injected"},
+			PatchOperations{
+				&AddOperation{Path: "/metadata/annotations/team", Value: "infra"},
+			},
+		},
+		{
+			nil,
+			PatchOperations{
+				&AddOperation{Path: "/metadata/annotations", Value: map[string]interface{}{}},
+				&AddOperation{Path: "/metadata/annotations/team", Value: "infra"},
+			},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			ops, err := patchMetadata("metadata.0.", "/metadata/", d, meta, func() (metav1.Object, error) {
+				return &metav1.ObjectMeta{Name: "test", Namespace: "default", Annotations: tc.Live}, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ops.Equal(tc.ExpectedOps) {
+				t.Fatalf("Unexpected operations.\nExpected: %#v\nGiven:    %#v", tc.ExpectedOps, ops)
+			}
+		})
+	}
+}
+
+func TestMergeInternalFinalizers(t *testing.T) {
+	testCases := []struct {
+		Current    []interface{}
+		Configured []interface{}
+		Expected   []string
+	}{
+		{
+			[]interface{}{"kubernetes.io/pvc-protection"},
+			[]interface{}{},
+			[]string{"kubernetes.io/pvc-protection"},
+		},
+		{
+			[]interface{}{"example.com/cleanup", "foregroundDeletion"},
+			[]interface{}{"example.com/other"},
+			[]string{"example.com/other", "foregroundDeletion"},
+		},
+		{
+			[]interface{}{"kubernetes.io/pvc-protection"},
+			[]interface{}{"example.com/cleanup", "kubernetes.io/pvc-protection"},
+			[]string{"example.com/cleanup", "kubernetes.io/pvc-protection"},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			out := mergeInternalFinalizers(tc.Current, tc.Configured)
+			if !reflect.DeepEqual(out, tc.Expected) {
+				t.Fatalf("Unexpected output.\nExpected: %#v\nGiven:    %#v", tc.Expected, out)
+			}
+		})
+	}
+}
+
+func TestSuppressInternalFinalizersDiff(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("config map", true),
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "default/test",
+		Attributes: map[string]string{
+			"id":                      "default/test",
+			"metadata.#":              "1",
+			"metadata.0.name":         "test",
+			"metadata.0.namespace":    "default",
+			"metadata.0.finalizers.#": "2",
+			"metadata.0.finalizers.0": "example.com/cleanup",
+			"metadata.0.finalizers.1": "kubernetes.io/pvc-protection",
+		},
+	}
+
+	testCases := []struct {
+		Finalizers   []interface{}
+		ExpectedDiff bool
+	}{
+		{[]interface{}{"example.com/cleanup"}, false},
+		{[]interface{}{"example.com/cleanup", "kubernetes.io/pvc-protection"}, false},
+		{[]interface{}{}, true},
+		{[]interface{}{"example.com/other"}, true},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"metadata": []interface{}{
+					map[string]interface{}{
+						"name":       "test",
+						"finalizers": tc.Finalizers,
+					},
+				},
+			})
+			diff, err := r.Diff(context.Background(), state, config, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			hasDiff := diff != nil && !diff.Empty()
+			if hasDiff != tc.ExpectedDiff {
+				t.Fatalf("Expected diff %t, got %#v", tc.ExpectedDiff, diff)
+			}
+		})
+	}
+}
+
+func TestExpandThenFlattenOwnerReferences(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"api_version":          "example.com/v1",
+			"kind":                 "Database",
+			"name":                 "main",
+			"uid":                  "0f5c4f8e-5b1e-4e2f-9d3c-0a6d1d5a1b2c",
+			"block_owner_deletion": true,
+			"controller":           true,
+		},
+		map[string]interface{}{
+			"api_version": "v1",
+			"kind":        "ConfigMap",
+			"name":        "parent",
+			"uid":         "6a8e0c7d-3f4b-4c2a-8e1d-9b7a5c3d2e1f",
+		},
+	}
+
+	out := flattenOwnerReferences(expandOwnerReferences(in))
+	if !reflect.DeepEqual(out, in) {
+		t.Fatalf("Unexpected output.\nExpected: %#v\nGiven:    %#v", in, out)
+	}
+}
+
+func TestFlattenTemplateMetadata(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"template": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: podTemplateFields("deployment"),
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+
+	meta := metav1.ObjectMeta{
+		Labels:          map[string]string{"app": "web"},
+		Finalizers:      []string{"example.com/cleanup"},
+		OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "parent", UID: "6a8e0c7d"}},
+	}
+	template := map[string]interface{}{
+		"metadata": flattenTemplateMetadata(meta, d, nil, "template.0."),
+	}
+	err := d.Set("template", []interface{}{template})
+	if err != nil {
+		t.Fatalf("Failed to set the template metadata: %s", err)
+	}
+	if labels := d.Get("template.0.metadata.0.labels").(map[string]interface{}); labels["app"] != "web" {
+		t.Fatalf("Unexpected labels: %#v", labels)
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apiValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/labels"
 	utilValidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
@@ -181,6 +183,14 @@ func validatePositiveInteger(value interface{}, key string) (ws []string, es []e
 	return
 }
 
+func validatePositiveFloat(value interface{}, key string) (ws []string, es []error) {
+	v := value.(float64)
+	if v <= 0 {
+		es = append(es, fmt.Errorf("%s must be greater than 0", key))
+	}
+	return
+}
+
 func validateTerminationGracePeriodSeconds(value interface{}, key string) (ws []string, es []error) {
 	v := value.(int)
 	if v < 0 {
@@ -290,3 +300,39 @@ func validateTypeStringNullableIntOrPercent(v interface{}, key string) (ws []str
 
 	return
 }
+
+// validateManifest checks that the fields identifying the object are set.
+// Their values may only be known on apply, so they are decoded there.
+func validateManifest(value interface{}, key string) (ws []string, es []error) {
+	v, ok := value.(map[string]interface{})
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be map", key))
+		return
+	}
+
+	for _, f := range []string{"apiVersion", "kind", "metadata"} {
+		if _, ok := v[f]; !ok {
+			es = append(es, fmt.Errorf("%s: missing %q", key, f))
+		}
+	}
+	return
+}
+
+func validateDuration(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("%s: cannot parse '%s' as a duration: %s", key, v, err))
+	} else if d < 0 {
+		es = append(es, fmt.Errorf("%s must not be negative", key))
+	}
+	return
+}
+
+func validateLabelSelectorString(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if _, err := labels.Parse(v); err != nil {
+		es = append(es, fmt.Errorf("%s: cannot parse '%s' as a label selector: %s", key, v, err))
+	}
+	return
+}
@@ -107,6 +107,21 @@ func TestValidateBase64EncodedMap(t *testing.T) {
 	}
 }
 
+func TestValidatePositiveFloat(t *testing.T) {
+	for _, data := range []float64{0.5, 1, 50} {
+		_, es := validatePositiveFloat(data, "qps")
+		if len(es) > 0 {
+			t.Fatalf("Expected %v to be valid: %#v", data, es)
+		}
+	}
+	for _, data := range []float64{0, -1} {
+		_, es := validatePositiveFloat(data, "qps")
+		if len(es) == 0 {
+			t.Fatalf("Expected %v to be invalid", data)
+		}
+	}
+}
+
 func TestValidateNonNegativeInteger(t *testing.T) {
 	validCases := []int{
 		0,